@@ -5,7 +5,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strconv"
 	"strings"
 
 	"golang.org/x/term"
@@ -14,34 +13,6 @@ import (
 	. "github.com/j-flat/go-veikkaus/goveikkaus"
 )
 
-func formatCurrency(amount int, currencySymbol string) string {
-	// Convert the integer amount to a string
-	amountStr := strconv.Itoa(amount)
-
-	// Insert commas to separate thousands
-	formattedAmount := insertCommas(amountStr)
-
-	// Insert currency symbol
-	formattedAmount += currencySymbol
-
-	return formattedAmount
-}
-
-func insertCommas(s string) string {
-	// Split the string into integer and fractional parts
-	parts := strings.Split(s, "")
-	integerPart := parts[:len(parts)-2]
-	fractionalPart := parts[len(parts)-2:]
-
-	// Insert commas to separate thousands
-	for i := len(integerPart) - 3; i > 0; i -= 3 {
-		integerPart = append(integerPart[:i], append([]string{","}, integerPart[i:]...)...)
-	}
-
-	// Combine integer and fractional parts with a comma separator
-	return strings.Join(integerPart, "") + "," + strings.Join(fractionalPart, "")
-}
-
 func main() {
 	r := bufio.NewReader(os.Stdin)
 	fmt.Println("Veikkaus username: ")
@@ -72,10 +43,10 @@ func main() {
 	}
 
 	fmt.Println("Account status:", balance.Status)
-	fmt.Printf("Account balance %s\n", formatCurrency(balance.Balances.Cash.Balance, balance.Balances.Cash.Currency))
+	fmt.Printf("Account balance %s\n", balance.Balances.Cash().Balance)
 	fmt.Println("Now logging out....")
 
-	if err := client.Auth.Logout(); err != nil {
+	if _, err := client.Auth.Logout(ctx); err != nil {
 		fmt.Printf("Logout was not successful. Error: %s", err)
 		os.Exit(1)
 	} else {
@@ -0,0 +1,118 @@
+package goveikkaus
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Service type: Account
+type AccountService service
+
+// HistoryOptions filters the account history listings, zero values are not sent
+type HistoryOptions struct {
+	// From and To limit the listing to the given time range
+	From time.Time
+	To   time.Time
+
+	GameType GameType
+	Status   string
+
+	// PageSize is the number of entries fetched per request, defaults to DefaultPageSize
+	PageSize int
+}
+
+type LimitType string
+
+const (
+	// LimitTypeLoss limits the wagers minus the winnings of the period
+	LimitTypeLoss LimitType = "LOSS"
+	// LimitTypeWager limits the wagers of the period
+	LimitTypeWager LimitType = "WAGER"
+)
+
+type LimitPeriod string
+
+const (
+	LimitPeriodDaily   LimitPeriod = "DAILY"
+	LimitPeriodWeekly  LimitPeriod = "WEEKLY"
+	LimitPeriodMonthly LimitPeriod = "MONTHLY"
+)
+
+// Request Payload Types for AccountService Endpoints
+
+// LimitChange requests a new amount for one of the player's limits. Decreases take effect
+// immediately, increases only after the waiting period set by Veikkaus.
+type LimitChange struct {
+	Type   LimitType   `json:"type"`
+	Period LimitPeriod `json:"period"`
+	Amount Money       `json:"amount"`
+}
+
+// End of Request payload types
+
+// Response Types for AccountService Endpoints
+
+// Limit is a single responsible gaming limit of the player
+type Limit struct {
+	Type   LimitType   `json:"type"`
+	Period LimitPeriod `json:"period"`
+	Amount Money       `json:"amount"`
+	// Remaining is the amount still available in the current period
+	Remaining Money `json:"remaining"`
+	// PendingAmount is the requested new amount, taking effect at PendingFrom
+	PendingAmount *Money    `json:"pendingAmount,omitempty"`
+	PendingFrom   Timestamp `json:"pendingFrom"`
+}
+
+type PlayerLimits struct {
+	Limits []Limit `json:"limits"`
+}
+
+// CheckWager returns *LimitExceededError for the first limit with less remaining than the cost of the wager
+func (l *PlayerLimits) CheckWager(cost Money) error {
+	for _, limit := range l.Limits {
+		if cost.Cmp(limit.Remaining) > 0 {
+			return &LimitExceededError{Limit: limit, Cost: cost}
+		}
+	}
+
+	return nil
+}
+
+// Transaction is a single money transfer on the player's account, e.g. a deposit, a wager or a win
+type Transaction struct {
+	ID          string    `json:"id"`
+	Time        Timestamp `json:"time"`
+	Type        string    `json:"type"`
+	Status      string    `json:"status"`
+	GameName    GameType  `json:"gameName,omitempty"`
+	Description string    `json:"description"`
+	Amount      Money     `json:"amount"`
+	// Balance is the account balance after the transaction
+	Balance Money `json:"balance"`
+}
+
+// WagerHistoryEntry is a single wager placed by the player
+type WagerHistoryEntry struct {
+	SerialNumber string    `json:"serialNumber"`
+	Time         Timestamp `json:"time"`
+	GameName     GameType  `json:"gameName"`
+	DrawID       string    `json:"drawId"`
+	Status       string    `json:"status"`
+	Price        Money     `json:"price"`
+	WinAmount    Money     `json:"winAmount"`
+}
+
+// End of Response Types for AccountService Endpoints
+
+// LimitExceededError is returned when a wager is refused locally because it would exceed the player's limit
+type LimitExceededError struct {
+	Limit Limit
+	Cost  Money
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("wager of %s exceeds the remaining %s %s limit %s",
+		e.Cost, strings.ToLower(string(e.Limit.Period)), strings.ToLower(string(e.Limit.Type)), e.Limit.Remaining)
+}
@@ -0,0 +1,50 @@
+package goveikkaus
+
+import (
+	"net/url"
+	"strconv"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+func getHistoryQuery(opts *HistoryOptions) url.Values {
+	query := url.Values{}
+	if opts == nil {
+		return query
+	}
+
+	if !opts.From.IsZero() {
+		query.Set("from", strconv.FormatInt(opts.From.UnixMilli(), 10))
+	}
+	if !opts.To.IsZero() {
+		query.Set("to", strconv.FormatInt(opts.To.UnixMilli(), 10))
+	}
+	if opts.GameType != "" {
+		query.Set("gameName", string(opts.GameType))
+	}
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+
+	return query
+}
+
+func getPageSize(opts *HistoryOptions) int {
+	if opts == nil {
+		return 0
+	}
+
+	return opts.PageSize
+}
+
+// Transactions returns a pager over the transactions of the player's account, newest first.
+// Requires an authenticated session, opts may be nil.
+func (s *AccountService) Transactions(opts *HistoryOptions) *Pager[Transaction] {
+	return newPager[Transaction](s.apiClient, api.AccountTransactionsEndpoint, getHistoryQuery(opts), getPageSize(opts))
+}
+
+// Wagers returns a pager over the wagers placed by the player, newest first.
+// Requires an authenticated session, opts may be nil.
+func (s *AccountService) Wagers(opts *HistoryOptions) *Pager[WagerHistoryEntry] {
+	return newPager[WagerHistoryEntry](s.apiClient, api.AccountWagersEndpoint, getHistoryQuery(opts), getPageSize(opts))
+}
@@ -0,0 +1,173 @@
+package goveikkaus
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+func getTransactionsPage(offset, limit, total int) []byte {
+	items := ""
+	for i := offset; i < offset+limit && i < total; i++ {
+		if items != "" {
+			items += ","
+		}
+		items += fmt.Sprintf(`{"id":"%d","time":1707051600000,"type":"WAGER","status":"COMPLETED","gameName":"SPORT","amount":-%d,"balance":1000}`, i, i+1)
+	}
+	return []byte(fmt.Sprintf(`{"total":%d,"items":[%s]}`, total, items))
+}
+
+var _ = Describe("accountservice: history", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+		client.SessionTimeout = time.Now().Add(time.Hour)
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("Transactions",
+		func(total, pageSize, expectedRequests int) {
+			requests := 0
+			mux.HandleFunc("/"+api.AccountTransactionsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+				limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+				Expect(limit).To(Equal(pageSize))
+				writeResponse(w, http.StatusOK, getTransactionsPage(offset, limit, total))
+			})
+
+			ctx := context.Background()
+			pager := client.Account.Transactions(&HistoryOptions{PageSize: pageSize})
+
+			var ids []string
+			for pager.Next(ctx) {
+				transaction := pager.Value()
+				Expect(transaction.Amount).To(Equal(Money{Cents: -int64(len(ids) + 1), Currency: DefaultCurrency}))
+				ids = append(ids, transaction.ID)
+			}
+
+			Expect(pager.Err()).To(BeNil())
+			Expect(ids).To(HaveLen(total))
+			Expect(requests).To(Equal(expectedRequests))
+		},
+		Entry("should return all entries of a single page", 3, 10, 1),
+		Entry("should fetch the following pages", 7, 3, 3),
+		Entry("should stop when the last page is full", 6, 3, 2),
+		Entry("should handle empty listing", 0, 10, 1),
+	)
+	Describe("Transactions", func() {
+		// collectTransactionIDs iterates over all transactions with the page size, returning the IDs and requests made
+		collectTransactionIDs := func(pageSize int, handle func(offset, limit int) []byte) ([]string, int) {
+			requests := 0
+			mux.HandleFunc("/"+api.AccountTransactionsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+				limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+				writeResponse(w, http.StatusOK, handle(offset, limit))
+			})
+
+			ctx := context.Background()
+			pager := client.Account.Transactions(&HistoryOptions{PageSize: pageSize})
+
+			var ids []string
+			for pager.Next(ctx) {
+				ids = append(ids, pager.Value().ID)
+			}
+			Expect(pager.Err()).To(BeNil())
+
+			return ids, requests
+		}
+
+		It("should fetch the pages until an empty page when the response has no total", func() {
+			ids, requests := collectTransactionIDs(3, func(offset, limit int) []byte {
+				return bytes.Replace(getTransactionsPage(offset, limit, 7), []byte(`"total":7,`), nil, 1)
+			})
+
+			Expect(ids).To(Equal([]string{"0", "1", "2", "3", "4", "5", "6"}))
+			Expect(requests).To(Equal(4))
+		})
+		It("should fetch the following pages when the server caps the limit below the page size", func() {
+			ids, requests := collectTransactionIDs(10, func(offset, limit int) []byte {
+				return getTransactionsPage(offset, min(limit, 3), 7)
+			})
+
+			Expect(ids).To(Equal([]string{"0", "1", "2", "3", "4", "5", "6"}))
+			Expect(requests).To(Equal(3))
+		})
+		It("should send the filters as query parameters", func() {
+			from := time.UnixMilli(1704067200000)
+			to := time.UnixMilli(1706745600000)
+
+			mux.HandleFunc("/"+api.AccountTransactionsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				query := r.URL.Query()
+				Expect(query.Get("from")).To(Equal("1704067200000"))
+				Expect(query.Get("to")).To(Equal("1706745600000"))
+				Expect(query.Get("gameName")).To(Equal("SPORT"))
+				Expect(query.Get("status")).To(Equal("COMPLETED"))
+				Expect(query.Get("limit")).To(Equal(strconv.Itoa(DefaultPageSize)))
+				writeResponse(w, http.StatusOK, getTransactionsPage(0, 1, 1))
+			})
+
+			pager := client.Account.Transactions(&HistoryOptions{From: from, To: to, GameType: GameTypeSport, Status: "COMPLETED"})
+
+			Expect(pager.Next(context.Background())).To(BeTrue())
+			Expect(pager.Next(context.Background())).To(BeFalse())
+			Expect(pager.Err()).To(BeNil())
+		})
+		It("should stop with error when fetching a page fails", func() {
+			mux.HandleFunc("/"+api.AccountTransactionsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusUnauthorized, notAuthenticatedBytes)
+			})
+
+			pager := client.Account.Transactions(nil)
+
+			Expect(pager.Next(context.Background())).To(BeFalse())
+			Expect(pager.Err()).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+			Expect(pager.Next(context.Background())).To(BeFalse())
+		})
+		It("should return error without an authenticated session", func() {
+			client.SessionTimeout = time.Time{}
+
+			pager := client.Account.Transactions(nil)
+
+			Expect(pager.Next(context.Background())).To(BeFalse())
+			Expect(pager.Err()).To(BeAssignableToTypeOf(&UserNotLoggedInError{}))
+		})
+	})
+	Describe("Wagers", func() {
+		It("should return the past wagers with typed amounts", func() {
+			mux.HandleFunc("/"+api.AccountWagersEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusOK, []byte(`{"total":1,"items":[{"serialNumber":"1234-5678","time":1707051600000,"gameName":"SPORT","drawId":"51234","status":"WON","price":30,"winAmount":215031}]}`))
+			})
+
+			pager := client.Account.Wagers(nil)
+
+			Expect(pager.Next(context.Background())).To(BeTrue())
+			Expect(pager.Value()).To(Equal(WagerHistoryEntry{
+				SerialNumber: "1234-5678",
+				Time:         Timestamp{time.UnixMilli(1707051600000)},
+				GameName:     GameTypeSport,
+				DrawID:       "51234",
+				Status:       "WON",
+				Price:        Money{Cents: 30, Currency: DefaultCurrency},
+				WinAmount:    Money{Cents: 215031, Currency: DefaultCurrency},
+			}))
+			Expect(pager.Next(context.Background())).To(BeFalse())
+			Expect(pager.Err()).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package goveikkaus
+
+import (
+	"context"
+	"net/http"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// Limits returns the player's daily, weekly and monthly limits with the remaining amounts.
+// Requires an authenticated session.
+func (s *AccountService) Limits(ctx context.Context) (*PlayerLimits, *http.Response, error) {
+	req, err := s.apiClient.NewRequest(ctx, http.MethodGet, api.AccountLimitsEndpoint, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var limits PlayerLimits
+
+	resp, err := s.apiClient.doJSON(ctx, req, &limits, true)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer resp.Body.Close()
+
+	return &limits, resp, nil
+}
@@ -0,0 +1,36 @@
+package goveikkaus
+
+import (
+	"context"
+	"net/http"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// RequestLimitChange requests a new amount for the limit and returns the limit as updated by Veikkaus.
+// Requires an authenticated session.
+func (s *AccountService) RequestLimitChange(ctx context.Context, change *LimitChange) (*Limit, *http.Response, error) {
+	body, err := api.GetJSONPayload(change)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.apiClient.NewRequest(ctx, http.MethodPut, api.AccountLimitsEndpoint, body)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var limit Limit
+
+	resp, err := s.apiClient.doJSON(ctx, req, &limit, true)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer resp.Body.Close()
+
+	return &limit, resp, nil
+}
@@ -0,0 +1,58 @@
+package goveikkaus
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var _ = Describe("accountservice: request limit change", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	var invalidLimitErrorBytes = []byte(`{"code":"INPUT_VALIDATION_FAILED","fieldErrors":[{"field":"amount","code":"TOO_LARGE","message":"amount too large"}]}`)
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+		client.SessionTimeout = time.Now().Add(time.Hour)
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("RequestLimitChange",
+		func(shouldSucceed bool, expectedStatusCode int, expectedErr error, expectedResponseBody []byte) {
+			mux.HandleFunc("/"+api.AccountLimitsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal(http.MethodPut))
+
+				body, err := io.ReadAll(r.Body)
+				Expect(err).To(BeNil())
+				Expect(string(body)).To(MatchJSON(`{"type":"LOSS","period":"WEEKLY","amount":30000}`))
+
+				writeResponse(w, expectedStatusCode, expectedResponseBody)
+			})
+
+			ctx := context.Background()
+			limit, _, err := client.Account.RequestLimitChange(ctx, &LimitChange{Type: LimitTypeLoss, Period: LimitPeriodWeekly, Amount: EuroCents(30000)})
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(limit.Amount).To(Equal(EuroCents(20000)))
+				Expect(*limit.PendingAmount).To(Equal(EuroCents(30000)))
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(limit).To(BeNil())
+			}
+		},
+		Entry("should return the limit with the pending change on happy-case", true, http.StatusOK, nil, []byte(`{"type":"LOSS","period":"WEEKLY","amount":20000,"remaining":18250,"pendingAmount":30000,"pendingFrom":1707051600000}`)),
+		Entry("should return validation error when the amount is not accepted", false, http.StatusBadRequest, &ValidationError{}, invalidLimitErrorBytes),
+	)
+})
@@ -0,0 +1,89 @@
+package goveikkaus
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var playerLimitsResponseBytes = []byte(`{"limits":[{"type":"LOSS","period":"DAILY","amount":5000,"remaining":3250},{"type":"LOSS","period":"WEEKLY","amount":20000,"remaining":18250,"pendingAmount":30000,"pendingFrom":1707051600000},{"type":"WAGER","period":"MONTHLY","amount":50000,"remaining":48250}]}`)
+
+var _ = Describe("accountservice: limits", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+		client.SessionTimeout = time.Now().Add(time.Hour)
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("Limits",
+		func(shouldSucceed bool, expectedStatusCode int, expectedErr error, expectedResponseBody []byte) {
+			mux.HandleFunc("/"+api.AccountLimitsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal(http.MethodGet))
+				writeResponse(w, expectedStatusCode, expectedResponseBody)
+			})
+
+			ctx := context.Background()
+			limits, _, err := client.Account.Limits(ctx)
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(limits.Limits).To(HaveLen(3))
+				Expect(limits.Limits[0]).To(Equal(Limit{Type: LimitTypeLoss, Period: LimitPeriodDaily, Amount: EuroCents(5000), Remaining: EuroCents(3250)}))
+				Expect(*limits.Limits[1].PendingAmount).To(Equal(EuroCents(30000)))
+				Expect(limits.Limits[1].PendingFrom.UnixMilli()).To(Equal(int64(1707051600000)))
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(limits).To(BeNil())
+			}
+		},
+		Entry("should return the limits on happy-case", true, http.StatusOK, nil, playerLimitsResponseBytes),
+		Entry("should return error when session has expired", false, http.StatusUnauthorized, &UnauthorizedError{}, notAuthenticatedBytes),
+	)
+	Describe("Limits", func() {
+		It("should return error without an authenticated session", func() {
+			client.SessionTimeout = time.Time{}
+
+			limits, _, err := client.Account.Limits(context.Background())
+
+			Expect(err).To(BeAssignableToTypeOf(&UserNotLoggedInError{}))
+			Expect(limits).To(BeNil())
+		})
+	})
+	DescribeTable("CheckWager",
+		func(cost int64, expectedLimit *Limit) {
+			limits := &PlayerLimits{Limits: []Limit{
+				{Type: LimitTypeLoss, Period: LimitPeriodDaily, Amount: EuroCents(5000), Remaining: EuroCents(3250)},
+				{Type: LimitTypeWager, Period: LimitPeriodMonthly, Amount: EuroCents(50000), Remaining: EuroCents(1000)},
+			}}
+
+			err := limits.CheckWager(EuroCents(cost))
+
+			if expectedLimit == nil {
+				Expect(err).To(BeNil())
+			} else {
+				Expect(err).To(Equal(&LimitExceededError{Limit: *expectedLimit, Cost: EuroCents(cost)}))
+			}
+		},
+		Entry("should accept the wager within all limits", int64(1000), nil),
+		Entry("should refuse the wager exceeding any of the limits", int64(1001), &Limit{Type: LimitTypeWager, Period: LimitPeriodMonthly, Amount: EuroCents(50000), Remaining: EuroCents(1000)}),
+	)
+	Describe("LimitExceededError", func() {
+		It("should describe the exceeded limit", func() {
+			err := &LimitExceededError{Limit: Limit{Type: LimitTypeLoss, Period: LimitPeriodDaily, Remaining: EuroCents(200)}, Cost: EuroCents(300)}
+
+			Expect(err.Error()).To(Equal("wager of 3,00 € exceeds the remaining daily loss limit 2,00 €"))
+		})
+	})
+})
@@ -1,5 +1,10 @@
 package goveikkaus
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // Service type: Auth
 type AuthService service
 
@@ -16,21 +21,101 @@ type LoginPayload struct {
 type LoginSuccessful = map[string]interface{}
 
 // Account Balance types
-type Balances struct {
-	Cash Cash `json:"CASH"`
+
+// BalanceKind is the kind of a balance bucket on the player's account
+type BalanceKind string
+
+const (
+	BalanceKindCash  BalanceKind = "CASH"
+	BalanceKindBonus BalanceKind = "BONUS"
+	// BalanceKindWinningsOnHold holds the winnings waiting to be paid to the account
+	BalanceKindWinningsOnHold BalanceKind = "WINNINGS_ON_HOLD"
+)
+
+// Balances are the balance buckets of the account keyed by their kind, including kinds unknown to this package
+type Balances map[BalanceKind]Balance
+
+// Cash returns the cash balance, which is the zero value when the account has none
+func (b Balances) Cash() Balance {
+	return b[BalanceKindCash]
 }
-type Cash struct {
-	Currency      string `json:"currency"`
-	Type          string `json:"type"`
-	Balance       int    `json:"balance"`
-	UsableBalance int    `json:"usableBalance"`
-	FrozenBalance int    `json:"frozenBalance"`
-	HoldBalance   int    `json:"holdBalance"`
+
+type Balance struct {
+	Currency      string      `json:"currency"`
+	Type          BalanceKind `json:"type"`
+	Balance       Money       `json:"balance"`
+	UsableBalance Money       `json:"usableBalance"`
+	FrozenBalance Money       `json:"frozenBalance"`
+	HoldBalance   Money       `json:"holdBalance"`
+
+	// Extra holds the fields not known to this package as sent by Veikkaus API
+	Extra map[string]json.RawMessage `json:"-"`
 }
+
+// UnmarshalJSON sets the currency of the balance to each of its amounts
+func (b *Balance) UnmarshalJSON(data []byte) error {
+	type balance Balance
+	if err := json.Unmarshal(data, (*balance)(b)); err != nil {
+		return err
+	}
+
+	if b.Currency != "" {
+		for _, amount := range []*Money{&b.Balance, &b.UsableBalance, &b.FrozenBalance, &b.HoldBalance} {
+			amount.Currency = b.Currency
+		}
+	}
+
+	extra, err := getUnknownFields(data, "currency", "type", "balance", "usableBalance", "frozenBalance", "holdBalance")
+	b.Extra = extra
+
+	return err
+}
+
 type AccountBalance struct {
-	Status        string   `json:"status"`
-	TimerInterval int      `json:"timerInterval"`
-	Balances      Balances `json:"balances"`
+	Status string `json:"status"`
+	// TimerInterval is the interval of the play time reminder, sent by Veikkaus API in minutes
+	TimerInterval time.Duration `json:"-"`
+	Balances      Balances      `json:"balances"`
+
+	// Extra holds the fields not known to this package as sent by Veikkaus API
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *AccountBalance) UnmarshalJSON(data []byte) error {
+	type accountBalance AccountBalance
+	raw := struct {
+		*accountBalance
+		TimerInterval int64 `json:"timerInterval"`
+	}{accountBalance: (*accountBalance)(a)}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	a.TimerInterval = time.Duration(raw.TimerInterval) * time.Minute
+
+	extra, err := getUnknownFields(data, "status", "timerInterval", "balances")
+	a.Extra = extra
+
+	return err
+}
+
+// getUnknownFields returns the fields of the JSON object other than the known ones, or nil when there are none
+func getUnknownFields(data []byte, knownFields ...string) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	for _, field := range knownFields {
+		delete(fields, field)
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	return fields, nil
 }
 
 // End of Response Types for AuthService Endpoints
@@ -8,11 +8,15 @@ import (
 )
 
 func (s *AuthService) AccountBalance(ctx context.Context) (*AccountBalance, *http.Response, error) {
-	req, _ := api.GetRequest(api.AccountBalanceEndpoint, http.MethodGet, nil)
+	req, err := s.apiClient.NewRequest(ctx, http.MethodGet, api.AccountBalanceEndpoint, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
 
 	var balance AccountBalance
 
-	resp, err := s.apiClient.Do(ctx, req, &balance)
+	resp, err := s.apiClient.doJSON(ctx, req, &balance, true)
 
 	if err != nil {
 		return nil, resp, err
@@ -2,8 +2,10 @@ package goveikkaus
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -23,6 +25,7 @@ var _ = Describe("authservice: account balance", func() {
 
 	BeforeEach(func() {
 		client, mux, _, teardown = setup()
+		client.SessionTimeout = time.Now().Add(time.Hour)
 	})
 
 	AfterEach(func() {
@@ -43,7 +46,7 @@ var _ = Describe("authservice: account balance", func() {
 
 			if shouldSucceed {
 				Expect(data).To(BeAssignableToTypeOf(&AccountBalance{}))
-				Expect(data.Balances.Cash.Balance).To(Equal(expectedBalance))
+				Expect(data.Balances.Cash().Balance).To(Equal(EuroCents(int64(expectedBalance))))
 				Expect(err).To(BeNil())
 			} else {
 				Expect(err).To(BeAssignableToTypeOf(expectedErr))
@@ -51,7 +54,30 @@ var _ = Describe("authservice: account balance", func() {
 			}
 		},
 		Entry("should return account balance on happy-case", true, http.StatusOK, HappyCaseBalance, nil, happyCaseResponseBytes),
-		Entry("should return error when response status code is unsupported, but response is otherwise successful", false, http.StatusMovedPermanently, nil, &api.UnsupportedStatusCodeError{}, happyCaseResponseBytes),
-		Entry("should return error when response errored and code is unknown", false, http.StatusBadRequest, nil, &api.APIErrorNotImplementedError{}, unknownErrorBytes),
+		Entry("should return error when response status code is unsupported, but response is otherwise successful", false, http.StatusMovedPermanently, nil, &UnsupportedStatusCodeError{}, happyCaseResponseBytes),
+		Entry("should return error when response errored and code is unknown", false, http.StatusBadRequest, nil, &APIError{}, unknownErrorBytes),
 	)
+	Describe("AccountBalance", func() {
+		It("should decode all balance kinds, the timer interval and unknown fields", func() {
+			mux.HandleFunc("/"+api.AccountBalanceEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusOK, []byte(`{"status":"ACTIVE","timerInterval":60,"selfExclusion":false,"balances":{"CASH":{"currency":"EUR","type":"CASH","balance":1577,"usableBalance":1500,"frozenBalance":0,"holdBalance":77},"BONUS":{"currency":"EUR","type":"BONUS","balance":500,"expiresAt":1707051600000},"LOYALTY":{"currency":"EUR","type":"LOYALTY","balance":20}}}`))
+			})
+
+			data, _, err := client.Auth.AccountBalance(context.Background())
+
+			Expect(err).To(BeNil())
+			Expect(data.TimerInterval).To(Equal(time.Hour))
+			Expect(data.Extra).To(HaveKeyWithValue("selfExclusion", json.RawMessage(`false`)))
+			Expect(data.Balances).To(HaveLen(3))
+			Expect(data.Balances.Cash().UsableBalance).To(Equal(EuroCents(1500)))
+			Expect(data.Balances.Cash().HoldBalance).To(Equal(EuroCents(77)))
+			Expect(data.Balances.Cash().Extra).To(BeNil())
+			Expect(data.Balances[BalanceKindBonus].Balance).To(Equal(EuroCents(500)))
+			Expect(data.Balances[BalanceKindBonus].Extra).To(HaveKeyWithValue("expiresAt", json.RawMessage(`1707051600000`)))
+			Expect(data.Balances[BalanceKind("LOYALTY")].Type).To(Equal(BalanceKind("LOYALTY")))
+		})
+		It("should return zero cash balance when the account has none", func() {
+			Expect(Balances{}.Cash()).To(Equal(Balance{}))
+		})
+	})
 })
@@ -2,6 +2,7 @@ package goveikkaus
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
@@ -20,11 +21,16 @@ func (s *AuthService) Login(ctx context.Context, username, password string) (*Lo
 		return nil, nil, err
 	}
 
-	req, _ := api.GetRequest(api.LoginEndpoint, http.MethodPost, body)
+	req, err := s.apiClient.NewRequest(ctx, http.MethodPost, api.LoginEndpoint, body)
+
+	if err != nil {
+		return nil, nil, err
+	}
 
 	var loginSuccessful LoginSuccessful
 
-	resp, err := s.apiClient.Do(ctx, req, &loginSuccessful)
+	// Failing login must never trigger another login
+	resp, err := s.apiClient.Do(withoutReauth(ctx), req, &loginSuccessful)
 
 	if err != nil {
 		return nil, resp, err
@@ -32,8 +38,13 @@ func (s *AuthService) Login(ctx context.Context, username, password string) (*Lo
 
 	defer resp.Body.Close()
 
-	// Store session timeout information and logged in state to client
-	s.apiClient.SessionTimeout = getSessionTimeout()
+	// Store session information and logged in state to client
+	s.apiClient.startSession(resp)
+
+	// Login itself succeeded even if persisting the session fails, so the result is returned alongside the error
+	if err := s.apiClient.SaveSession(ctx); err != nil {
+		return &loginSuccessful, resp, fmt.Errorf("could not save the session to session store: %w", err)
+	}
 
 	// Veikkaus API Returns empty JSON-response, no need to parse it to an empty object
 	return &loginSuccessful, resp, nil
@@ -19,7 +19,7 @@ func ReturnError(v any) ([]byte, error) {
 
 var validationErrors = api.ErrorResponse{
 	Code: "INPUT_VALIDATION_FAILED",
-	FieldErrors: []api.FieldError{
+	FieldErrors: []FieldError{
 		{
 			Field:   "login",
 			Code:    "EMPTY",
@@ -80,16 +80,22 @@ var _ = Describe("authservice: login", func() {
 			}
 		},
 		Entry("should login successfully in the happy case", true, http.StatusOK, nil, sucessfulRequestBytes),
-		Entry("should return error if login is unsuccessful due to wrong credentials", false, http.StatusUnauthorized, &api.UnauthorizedError{}, unauthorizedErrorBytes),
-		Entry("should return error when input validation fails", false, http.StatusBadRequest, &api.ValidationError{}, getInputValidationErrorBytes()),
-		Entry("should return error when response status code is unsupported, but response is otherwise successful", false, http.StatusMovedPermanently, &api.UnsupportedStatusCodeError{}, sucessfulRequestBytes),
-		Entry("should return error when response errored and code is unknown", false, http.StatusBadRequest, &api.APIErrorNotImplementedError{}, unknownErrorBytes),
-		Entry("should return error for nil response body", false, http.StatusServiceUnavailable, &json.SyntaxError{}, nil),
-		Entry("should return error when error-response from API is not in supported format", false, http.StatusInternalServerError, &json.SyntaxError{}, nil),
+		Entry("should return error if login is unsuccessful due to wrong credentials", false, http.StatusUnauthorized, &UnauthorizedError{}, unauthorizedErrorBytes),
+		Entry("should return error when input validation fails", false, http.StatusBadRequest, &ValidationError{}, getInputValidationErrorBytes()),
+		Entry("should return error when response status code is unsupported, but response is otherwise successful", false, http.StatusMovedPermanently, &UnsupportedStatusCodeError{}, sucessfulRequestBytes),
+		Entry("should return error when response errored and code is unknown", false, http.StatusBadRequest, &APIError{}, unknownErrorBytes),
+		Entry("should return error for nil response body", false, http.StatusServiceUnavailable, &APIError{}, nil),
+		Entry("should return error when error-response from API is not in supported format", false, http.StatusInternalServerError, &APIError{}, []byte("<html><body>Internal Server Error</body></html>")),
 	)
 	Describe("Login", func() {
 		It("should return error when request-payload byte conversion fails", func() {
+			originalJSONMarshal := api.JSONMarshal
 			api.JSONMarshal = ReturnError
+
+			defer func() {
+				api.JSONMarshal = originalJSONMarshal
+			}()
+
 			mux.HandleFunc("/"+api.LoginEndpoint, func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusBadRequest)
 				if _, err := w.Write(nil); err != nil {
@@ -100,7 +106,7 @@ var _ = Describe("authservice: login", func() {
 			ctx := context.Background()
 			data, _, err := client.Auth.Login(ctx, username, password)
 
-			Expect(err).To(BeAssignableToTypeOf(&api.RequestPayloadError{}))
+			Expect(err).To(BeAssignableToTypeOf(&RequestPayloadError{}))
 			Expect(data).To(BeNil())
 		})
 	})
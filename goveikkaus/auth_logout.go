@@ -1,15 +1,35 @@
 package goveikkaus
 
 import (
-	errors "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+	"context"
+	"net/http"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
 )
 
-func (s *AuthService) Logout() error {
-	if s.apiClient.client.Jar == nil {
-		return &errors.UserNotLoggedInError{}
-	} else {
-		s.apiClient.client.Jar = nil
+// Logout invalidates the session on Veikkaus API and clears the local session state. The session is
+// invalidated also when its local expiry estimate has passed, as long as the session cookie is still held.
+func (s *AuthService) Logout(ctx context.Context) (*http.Response, error) {
+	if !s.apiClient.hasSession() {
+		return nil, &UserNotLoggedInError{}
 	}
 
-	return nil
+	req, err := s.apiClient.NewRequest(ctx, http.MethodDelete, api.LoginEndpoint, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.apiClient.do(withoutReauth(ctx), req)
+
+	// The local session is ended also when the session is already gone on the server
+	if err != nil {
+		return resp, err
+	}
+
+	defer resp.Body.Close()
+
+	s.apiClient.endSession()
+
+	return resp, s.apiClient.clearSessionStore(ctx, nil)
 }
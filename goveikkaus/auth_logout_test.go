@@ -1,14 +1,17 @@
 package goveikkaus
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"net/url"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
-	errors "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
 )
 
 // MockCookieJar implements http.CookieJar for testing purposes.
@@ -41,40 +44,130 @@ func getDummyCookies() []*http.Cookie {
 }
 
 var _ = Describe("authservice: logout", func() {
-	var url *url.URL
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+	var logoutCalls int
 
 	BeforeEach(func() {
-		url, _ = url.Parse("http://localhost")
+		client, mux, _, teardown = setup()
+		logoutCalls = 0
 	})
 
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	handleSessions := func(logoutStatusCode int, logoutResponseBody []byte) {
+		mux.HandleFunc("/"+api.LoginEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodDelete {
+				logoutCalls++
+				w.WriteHeader(logoutStatusCode)
+				if _, err := w.Write(logoutResponseBody); err != nil {
+					log.Fatalf("could not write response-body in unit-test: %v", err)
+				}
+				return
+			}
+			http.SetCookie(w, &http.Cookie{Name: api.AuthSessionCookie, Value: "abc123", Path: "/"})
+			if _, err := w.Write([]byte(`{}`)); err != nil {
+				log.Fatalf("could not write response-body in unit-test: %v", err)
+			}
+		})
+	}
+
+	login := func() {
+		_, _, err := client.Auth.Login(context.Background(), "johndoe", "verysecret")
+		Expect(err).To(BeNil())
+		Expect(client.client.Jar.Cookies(client.BaseURL)).NotTo(BeEmpty())
+	}
+
 	Describe("Logout", func() {
-		It("should logout from existing session", func() {
+		It("should invalidate the session on the server and clear the cookie jar", func() {
+			handleSessions(http.StatusNoContent, nil)
+			login()
+
+			resp, err := client.Auth.Logout(context.Background())
+
+			Expect(err).To(BeNil())
+			Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+			Expect(logoutCalls).To(Equal(1))
+			Expect(client.client.Jar).NotTo(BeNil())
+			Expect(client.client.Jar.Cookies(client.BaseURL)).To(BeEmpty())
+			Expect(client.SessionTimeout.IsZero()).To(BeTrue())
+			Expect(client.Session()).To(BeNil())
+			Expect(client.UserIsLoggedIn()).To(BeFalse())
+		})
+		It("should expire cookies of jars that cannot be cleared", func() {
 			var dummyJar = &MockCookieJar{}
+			dummyJar.SetCookies(client.BaseURL, getDummyCookies())
+			client.client.Jar = dummyJar
 
-			dummyJar.SetCookies(url, getDummyCookies())
-			client := &http.Client{
-				Jar: dummyJar,
+			handleSessions(http.StatusOK, nil)
+			login()
+
+			_, err := client.Auth.Logout(context.Background())
+
+			Expect(err).To(BeNil())
+			for _, cookie := range dummyJar.Cookies(client.BaseURL) {
+				Expect(cookie.MaxAge).To(BeNumerically("<", 0))
 			}
+		})
+		It("should invalidate the session on the server after its local expiry estimate has passed", func() {
+			handleSessions(http.StatusNoContent, nil)
+			store := &MemorySessionStore{}
+			client.SessionStore = store
+			login()
+			client.SessionTimeout = time.Now().Add(-time.Minute)
+			Expect(client.UserIsLoggedIn()).To(BeFalse())
 
-			veikkausClient := NewClient(client)
-			Expect(len(veikkausClient.client.Jar.Cookies(url))).NotTo(BeZero())
+			_, err := client.Auth.Logout(context.Background())
 
-			err := veikkausClient.Auth.Logout()
-			Expect(veikkausClient.client.Jar).To(BeNil())
 			Expect(err).To(BeNil())
+			Expect(logoutCalls).To(Equal(1))
+			Expect(client.client.Jar.Cookies(client.BaseURL)).To(BeEmpty())
+			Expect(client.Session()).To(BeNil())
 
+			stored, _ := store.Load(context.Background())
+			Expect(stored).To(BeNil())
+		})
+		It("should invalidate the session held only in the cookie jar", func() {
+			handleSessions(http.StatusNoContent, nil)
+			client.client.Jar.SetCookies(client.BaseURL, []*http.Cookie{{Name: api.AuthSessionCookie, Value: "abc123", Path: "/"}})
+
+			_, err := client.Auth.Logout(context.Background())
+
+			Expect(err).To(BeNil())
+			Expect(logoutCalls).To(Equal(1))
+			Expect(client.client.Jar.Cookies(client.BaseURL)).To(BeEmpty())
 		})
 		It("should return error when no login session is active", func() {
-			client := &http.Client{}
-			veikkausClient := NewClient(client)
+			handleSessions(http.StatusOK, nil)
+
+			resp, err := client.Auth.Logout(context.Background())
+
+			Expect(resp).To(BeNil())
+			Expect(err).To(BeAssignableToTypeOf(&UserNotLoggedInError{}))
+			Expect(logoutCalls).To(BeZero())
+			Expect(client.client.Jar).NotTo(BeNil())
+		})
+		It("should return the API error and keep the session when logout fails", func() {
+			handleSessions(http.StatusBadRequest, []byte(`{"code":"UNKNOWN","fieldErrors":[]}`))
+			login()
+
+			_, err := client.Auth.Logout(context.Background())
 
-			Expect(veikkausClient.client.Jar).To(BeNil())
+			Expect(err).To(BeAssignableToTypeOf(&APIError{}))
+			Expect(client.UserIsLoggedIn()).To(BeTrue())
+		})
+		It("should clear the local session when the server session has already expired", func() {
+			handleSessions(http.StatusUnauthorized, notAuthenticatedBytes)
+			login()
 
-			err := veikkausClient.Auth.Logout()
+			_, err := client.Auth.Logout(context.Background())
 
-			Expect(err).NotTo(BeNil())
-			Expect(veikkausClient.client.Jar).To(BeNil())
-			Expect(err).To(BeAssignableToTypeOf(&errors.UserNotLoggedInError{}))
+			Expect(err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+			Expect(client.UserIsLoggedIn()).To(BeFalse())
+			Expect(client.client.Jar.Cookies(client.BaseURL)).To(BeEmpty())
 		})
 	})
 })
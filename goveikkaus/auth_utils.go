@@ -7,6 +7,9 @@ import (
 )
 
 func (s *AuthService) AuthSessionIsActive() bool {
+	s.apiClient.sessionMu.Lock()
+	defer s.apiClient.sessionMu.Unlock()
+
 	return time.Now().Before(s.apiClient.SessionTimeout)
 }
 
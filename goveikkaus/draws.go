@@ -0,0 +1,165 @@
+package goveikkaus
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Service type: Draws
+type DrawsService service
+
+// Game types served by the sport game draw endpoints
+var drawGameTypes = []GameType{
+	GameTypeSport,
+	GameTypeMultiScore,
+	GameTypeScore,
+	GameTypeWinner,
+	GameTypePickTwo,
+	GameTypePickThree,
+	GameTypePerfecta,
+	GameTypeTrifecta,
+}
+
+func validateDrawGameType(gameType GameType) error {
+	for _, drawGameType := range drawGameTypes {
+		if gameType == drawGameType {
+			return nil
+		}
+	}
+
+	return &UnsupportedGameTypeError{GameType: string(gameType)}
+}
+
+// Timestamp is a point in time, sent by Veikkaus API as milliseconds since Unix epoch
+type Timestamp struct {
+	time.Time
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	millis, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	t.Time = time.UnixMilli(millis)
+
+	return nil
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(strconv.FormatInt(t.UnixMilli(), 10)), nil
+}
+
+// Response Types for DrawsService Endpoints
+type Competitor struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Number int    `json:"number"`
+	Status string `json:"status"`
+}
+
+type DrawRow struct {
+	ID          string       `json:"id"`
+	Status      string       `json:"status"`
+	Type        string       `json:"type"`
+	Name        string       `json:"name"`
+	ShortName   string       `json:"shortName"`
+	Description string       `json:"description"`
+	SportID     string       `json:"sportId"`
+	Competitors []Competitor `json:"competitors"`
+}
+
+type GameRuleSet struct {
+	BasePrice      int    `json:"basePrice"`
+	MaxPrice       int    `json:"maxPrice"`
+	StakeInterval  int    `json:"stakeInterval"`
+	MinStake       int    `json:"minStake"`
+	MaxStake       int    `json:"maxStake"`
+	SelectionPrice int    `json:"selectionPrice"`
+	OddsType       string `json:"oddsType"`
+}
+
+type Draw struct {
+	ID                   string      `json:"id"`
+	GameName             GameType    `json:"gameName"`
+	BrandName            string      `json:"brandName"`
+	Name                 string      `json:"name"`
+	Status               string      `json:"status"`
+	OpenTime             Timestamp   `json:"openTime"`
+	CloseTime            Timestamp   `json:"closeTime"`
+	DrawTime             Timestamp   `json:"drawTime"`
+	ResultsAvailableTime Timestamp   `json:"resultsAvailableTime"`
+	GameRuleSet          GameRuleSet `json:"gameRuleSet"`
+	Rows                 []DrawRow   `json:"rows"`
+}
+
+// Odds is the odds of an outcome in hundredths, e.g. 1250 is odds 12.50
+type Odds int64
+
+func (o Odds) Float64() float64 {
+	return float64(o) / 100
+}
+
+func (o Odds) String() string {
+	return fmt.Sprintf("%d.%02d", o/100, o%100)
+}
+
+// Percentage is a share in hundredths of a percent, e.g. 4532 is 45.32 %
+type Percentage int64
+
+func (p Percentage) Float64() float64 {
+	return float64(p) / 100
+}
+
+func (p Percentage) String() string {
+	return fmt.Sprintf("%d.%02d %%", p/100, p%100)
+}
+
+// ScoreOddsMatrix holds the odds of a score row, Odds[home][away] being the odds of the final score home-away
+type ScoreOddsMatrix struct {
+	RowID string
+	Odds  [][]Odds
+}
+
+// Get returns the odds of the given score, and false when the score is not available
+func (m *ScoreOddsMatrix) Get(homeScore, awayScore int) (Odds, bool) {
+	if homeScore < 0 || homeScore >= len(m.Odds) || awayScore < 0 || awayScore >= len(m.Odds[homeScore]) {
+		return 0, false
+	}
+
+	odds := m.Odds[homeScore][awayScore]
+
+	return odds, odds > 0
+}
+
+type DrawOdds struct {
+	DrawID     string
+	GameName   GameType
+	UpdateTime Timestamp
+	Rows       []ScoreOddsMatrix
+}
+
+// OutcomePopularity is the share of the stakes placed on each outcome of a Vakio row
+type OutcomePopularity struct {
+	RowID string
+	Home  Percentage
+	Tie   Percentage
+	Away  Percentage
+}
+
+type DrawPopularity struct {
+	DrawID     string
+	UpdateTime Timestamp
+	Rows       []OutcomePopularity
+}
+
+// End of Response Types for DrawsService Endpoints
@@ -0,0 +1,36 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// Get returns a single draw of the given game type by its ID
+func (s *DrawsService) Get(ctx context.Context, gameType GameType, drawID string) (*Draw, *http.Response, error) {
+	if err := validateDrawGameType(gameType); err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf(api.DrawEndpoint, gameType, url.PathEscape(drawID))
+	req, err := s.apiClient.NewRequest(ctx, http.MethodGet, endpoint, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var draw Draw
+
+	resp, err := s.apiClient.Do(ctx, req, &draw)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer resp.Body.Close()
+
+	return &draw, resp, nil
+}
@@ -0,0 +1,92 @@
+package goveikkaus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var drawResponseBytes = []byte(`{"id":"98765","gameName":"MULTISCORE","name":"Moniveto","status":"OPEN","closeTime":1707051600000,"rows":[{"id":"1","type":"SCORE","name":"Ilves - Tappara"}]}`)
+
+var _ = Describe("drawsservice: get", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	var notFoundErrorBytes = []byte(`{"code": "NOT_FOUND", "fieldErrors": []}`)
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("Get",
+		func(shouldSucceed bool, expectedStatusCode int, expectedErr error, expectedResponseBody []byte) {
+			mux.HandleFunc("/"+fmt.Sprintf(api.DrawEndpoint, GameTypeMultiScore, "98765"), func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(expectedStatusCode)
+				if _, err := w.Write(expectedResponseBody); err != nil {
+					log.Fatalf("Error while writing the response body in unit-test: %v", err)
+				}
+			})
+
+			ctx := context.Background()
+			draw, _, err := client.Draws.Get(ctx, GameTypeMultiScore, "98765")
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(draw.ID).To(Equal("98765"))
+				Expect(draw.GameName).To(Equal(GameTypeMultiScore))
+				Expect(draw.Rows).To(HaveLen(1))
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(draw).To(BeNil())
+			}
+		},
+		Entry("should return the draw on happy-case", true, http.StatusOK, nil, drawResponseBytes),
+		Entry("should return error when draw is not found", false, http.StatusNotFound, &APIError{}, notFoundErrorBytes),
+	)
+	Describe("Get", func() {
+		It("should return error for game types without draws", func() {
+			ctx := context.Background()
+			draw, _, err := client.Draws.Get(ctx, GameTypeFixedOdds, "1")
+
+			Expect(err).To(BeAssignableToTypeOf(&UnsupportedGameTypeError{}))
+			Expect(draw).To(BeNil())
+		})
+	})
+	Describe("Timestamp", func() {
+		It("should marshal and unmarshal epoch milliseconds", func() {
+			var timestamp Timestamp
+			Expect(json.Unmarshal([]byte(`1707051600000`), &timestamp)).To(Succeed())
+			Expect(timestamp.Equal(time.UnixMilli(1707051600000))).To(BeTrue())
+
+			bytes, err := json.Marshal(timestamp)
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("1707051600000"))
+		})
+		It("should handle null and zero values", func() {
+			var timestamp Timestamp
+			Expect(json.Unmarshal([]byte(`null`), &timestamp)).To(Succeed())
+			Expect(timestamp.IsZero()).To(BeTrue())
+
+			bytes, err := json.Marshal(timestamp)
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("null"))
+		})
+		It("should return error for non-numeric values", func() {
+			var timestamp Timestamp
+			Expect(json.Unmarshal([]byte(`"yesterday"`), &timestamp)).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// List returns the open draws for the given game type
+func (s *DrawsService) List(ctx context.Context, gameType GameType) ([]*Draw, *http.Response, error) {
+	if err := validateDrawGameType(gameType); err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf(api.DrawsEndpoint, gameType)
+	req, err := s.apiClient.NewRequest(ctx, http.MethodGet, endpoint, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var draws []*Draw
+
+	resp, err := s.apiClient.Do(ctx, req, &draws)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer resp.Body.Close()
+
+	return draws, resp, nil
+}
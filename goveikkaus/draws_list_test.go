@@ -0,0 +1,72 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var drawListResponseBytes = []byte(`[{"id":"51234","gameName":"SPORT","brandName":"1","name":"Vakio 1","status":"OPEN","openTime":1706911200000,"closeTime":1707051600000,"gameRuleSet":{"basePrice":10,"maxPrice":100000,"stakeInterval":5,"minStake":10,"maxStake":25,"selectionPrice":10,"oddsType":"NONE"},"rows":[{"id":"1","status":"OPEN","type":"1X2","name":"HJK - KuPS","shortName":"HJK-KuPS","competitors":[{"id":"1","name":"HJK","number":1,"status":"ACTIVE"},{"id":"2","name":"KuPS","number":2,"status":"ACTIVE"}]}]},{"id":"51235","gameName":"SPORT","brandName":"2","name":"Vakio 2","status":"OPEN","rows":[]}]`)
+
+var _ = Describe("drawsservice: list", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	var unknownErrorBytes = []byte(`{"code": "UNKNOWN", "fieldErrors": []}`)
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("List",
+		func(shouldSucceed bool, expectedStatusCode, expectedDrawCount int, expectedErr error, expectedResponseBody []byte) {
+			mux.HandleFunc("/"+fmt.Sprintf(api.DrawsEndpoint, GameTypeSport), func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal(http.MethodGet))
+				w.WriteHeader(expectedStatusCode)
+				if _, err := w.Write(expectedResponseBody); err != nil {
+					log.Fatalf("Error while writing the response body in unit-test: %v", err)
+				}
+			})
+
+			ctx := context.Background()
+			draws, _, err := client.Draws.List(ctx, GameTypeSport)
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(draws).To(HaveLen(expectedDrawCount))
+				Expect(draws[0].GameName).To(Equal(GameTypeSport))
+				Expect(draws[0].CloseTime.UnixMilli()).To(Equal(int64(1707051600000)))
+				Expect(draws[0].GameRuleSet.MaxStake).To(Equal(25))
+				Expect(draws[0].Rows[0].Competitors).To(HaveLen(2))
+				Expect(draws[1].CloseTime.IsZero()).To(BeTrue())
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(draws).To(BeNil())
+			}
+		},
+		Entry("should return open draws on happy-case", true, http.StatusOK, 2, nil, drawListResponseBytes),
+		Entry("should return error when response status code is unsupported", false, http.StatusMovedPermanently, 0, &UnsupportedStatusCodeError{}, drawListResponseBytes),
+		Entry("should return error when response errored and code is unknown", false, http.StatusBadRequest, 0, &APIError{}, unknownErrorBytes),
+	)
+	Describe("List", func() {
+		It("should return error for game types without draws", func() {
+			ctx := context.Background()
+			draws, resp, err := client.Draws.List(ctx, GameTypeFixedOdds)
+
+			Expect(err).To(BeAssignableToTypeOf(&UnsupportedGameTypeError{}))
+			Expect(resp).To(BeNil())
+			Expect(draws).To(BeNil())
+		})
+	})
+})
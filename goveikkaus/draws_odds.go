@@ -0,0 +1,84 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+type scoreOdds struct {
+	HomeScore int  `json:"homeScore"`
+	AwayScore int  `json:"awayScore"`
+	Odds      Odds `json:"odds"`
+}
+
+type rowOdds struct {
+	RowID string      `json:"rowId"`
+	Odds  []scoreOdds `json:"odds"`
+}
+
+type drawOddsResponse struct {
+	DrawID     string    `json:"drawId"`
+	GameName   GameType  `json:"gameName"`
+	UpdateTime Timestamp `json:"updateTime"`
+	Rows       []rowOdds `json:"rows"`
+}
+
+func newScoreOddsMatrix(row rowOdds) ScoreOddsMatrix {
+	maxHomeScore, maxAwayScore := -1, -1
+	for _, odds := range row.Odds {
+		maxHomeScore = max(maxHomeScore, odds.HomeScore)
+		maxAwayScore = max(maxAwayScore, odds.AwayScore)
+	}
+
+	matrix := ScoreOddsMatrix{RowID: row.RowID, Odds: make([][]Odds, maxHomeScore+1)}
+	for homeScore := range matrix.Odds {
+		matrix.Odds[homeScore] = make([]Odds, maxAwayScore+1)
+	}
+
+	for _, odds := range row.Odds {
+		if odds.HomeScore >= 0 && odds.AwayScore >= 0 {
+			matrix.Odds[odds.HomeScore][odds.AwayScore] = odds.Odds
+		}
+	}
+
+	return matrix
+}
+
+// Odds returns the current odds of an open Moniveto (MULTISCORE) or Tulosveto (SCORE) draw as score matrices
+func (s *DrawsService) Odds(ctx context.Context, gameType GameType, drawID string) (*DrawOdds, *http.Response, error) {
+	if !isScoreGame(gameType) {
+		return nil, nil, &UnsupportedGameTypeError{GameType: string(gameType)}
+	}
+
+	endpoint := fmt.Sprintf(api.DrawOddsEndpoint, gameType, url.PathEscape(drawID))
+	req, err := s.apiClient.NewRequest(ctx, http.MethodGet, endpoint, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response drawOddsResponse
+
+	resp, err := s.apiClient.Do(ctx, req, &response)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer resp.Body.Close()
+
+	drawOdds := &DrawOdds{
+		DrawID:     response.DrawID,
+		GameName:   response.GameName,
+		UpdateTime: response.UpdateTime,
+	}
+	for _, row := range response.Rows {
+		drawOdds.Rows = append(drawOdds.Rows, newScoreOddsMatrix(row))
+	}
+
+	return drawOdds, resp, nil
+}
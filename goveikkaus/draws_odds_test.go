@@ -0,0 +1,91 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var drawOddsResponseBytes = []byte(`{"drawId":"98765","gameName":"MULTISCORE","updateTime":1707051600000,"rows":[{"rowId":"1","odds":[{"homeScore":0,"awayScore":0,"odds":1250},{"homeScore":1,"awayScore":0,"odds":875},{"homeScore":2,"awayScore":1,"odds":1490}]}]}`)
+
+var _ = Describe("drawsservice: odds", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	var notFoundErrorBytes = []byte(`{"code": "NOT_FOUND", "fieldErrors": []}`)
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("Odds",
+		func(shouldSucceed bool, expectedStatusCode int, expectedErr error, expectedResponseBody []byte) {
+			mux.HandleFunc("/"+fmt.Sprintf(api.DrawOddsEndpoint, GameTypeMultiScore, "98765"), func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(expectedStatusCode)
+				if _, err := w.Write(expectedResponseBody); err != nil {
+					log.Fatalf("Error while writing the response body in unit-test: %v", err)
+				}
+			})
+
+			ctx := context.Background()
+			drawOdds, _, err := client.Draws.Odds(ctx, GameTypeMultiScore, "98765")
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(drawOdds.DrawID).To(Equal("98765"))
+				Expect(drawOdds.GameName).To(Equal(GameTypeMultiScore))
+				Expect(drawOdds.UpdateTime.UnixMilli()).To(Equal(int64(1707051600000)))
+				Expect(drawOdds.Rows).To(HaveLen(1))
+				Expect(drawOdds.Rows[0].RowID).To(Equal("1"))
+				Expect(drawOdds.Rows[0].Odds).To(Equal([][]Odds{{1250, 0}, {875, 0}, {0, 1490}}))
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(drawOdds).To(BeNil())
+			}
+		},
+		Entry("should return the odds matrices on happy-case", true, http.StatusOK, nil, drawOddsResponseBytes),
+		Entry("should return error when draw is not found", false, http.StatusNotFound, &APIError{}, notFoundErrorBytes),
+	)
+	Describe("Odds", func() {
+		It("should return error for game types without score odds", func() {
+			ctx := context.Background()
+			drawOdds, _, err := client.Draws.Odds(ctx, GameTypeSport, "1")
+
+			Expect(err).To(BeAssignableToTypeOf(&UnsupportedGameTypeError{}))
+			Expect(drawOdds).To(BeNil())
+		})
+	})
+	DescribeTable("ScoreOddsMatrix.Get",
+		func(homeScore, awayScore int, expectedOdds Odds, expectedOk bool) {
+			matrix := ScoreOddsMatrix{RowID: "1", Odds: [][]Odds{{1250, 0}, {875, 0}, {0, 1490}}}
+
+			odds, ok := matrix.Get(homeScore, awayScore)
+
+			Expect(odds).To(Equal(expectedOdds))
+			Expect(ok).To(Equal(expectedOk))
+		},
+		Entry("should return the odds of an available score", 2, 1, Odds(1490), true),
+		Entry("should return false for a score without odds", 0, 1, Odds(0), false),
+		Entry("should return false for a score outside of the matrix", 3, 0, Odds(0), false),
+		Entry("should return false for a negative score", -1, 0, Odds(0), false),
+	)
+	DescribeTable("Odds.String",
+		func(odds Odds, expected string, expectedFloat float64) {
+			Expect(odds.String()).To(Equal(expected))
+			Expect(odds.Float64()).To(Equal(expectedFloat))
+		},
+		Entry("should format two decimals", Odds(1250), "12.50", 12.5),
+		Entry("should pad the decimals", Odds(105), "1.05", 1.05),
+	)
+})
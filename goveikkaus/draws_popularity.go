@@ -0,0 +1,56 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+type rowPopularity struct {
+	RowID       string                `json:"rowId"`
+	Percentages map[string]Percentage `json:"percentages"`
+}
+
+type drawPopularityResponse struct {
+	DrawID     string          `json:"drawId"`
+	UpdateTime Timestamp       `json:"updateTime"`
+	Rows       []rowPopularity `json:"rows"`
+}
+
+// Popularity returns the share of stakes placed on each outcome of the rows of an open Vakio (SPORT) draw
+func (s *DrawsService) Popularity(ctx context.Context, drawID string) (*DrawPopularity, *http.Response, error) {
+	endpoint := fmt.Sprintf(api.DrawPopularityEndpoint, GameTypeSport, url.PathEscape(drawID))
+	req, err := s.apiClient.NewRequest(ctx, http.MethodGet, endpoint, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response drawPopularityResponse
+
+	resp, err := s.apiClient.Do(ctx, req, &response)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer resp.Body.Close()
+
+	popularity := &DrawPopularity{
+		DrawID:     response.DrawID,
+		UpdateTime: response.UpdateTime,
+	}
+	for _, row := range response.Rows {
+		popularity.Rows = append(popularity.Rows, OutcomePopularity{
+			RowID: row.RowID,
+			Home:  row.Percentages["1"],
+			Tie:   row.Percentages["X"],
+			Away:  row.Percentages["2"],
+		})
+	}
+
+	return popularity, resp, nil
+}
@@ -0,0 +1,66 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var drawPopularityResponseBytes = []byte(`{"drawId":"51234","updateTime":1707051600000,"rows":[{"rowId":"1","percentages":{"1":4532,"X":2711,"2":2757}},{"rowId":"2","percentages":{"1":1500,"X":3000,"2":5500}}]}`)
+
+var _ = Describe("drawsservice: popularity", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	var notFoundErrorBytes = []byte(`{"code": "NOT_FOUND", "fieldErrors": []}`)
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("Popularity",
+		func(shouldSucceed bool, expectedStatusCode int, expectedErr error, expectedResponseBody []byte) {
+			mux.HandleFunc("/"+fmt.Sprintf(api.DrawPopularityEndpoint, GameTypeSport, "51234"), func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(expectedStatusCode)
+				if _, err := w.Write(expectedResponseBody); err != nil {
+					log.Fatalf("Error while writing the response body in unit-test: %v", err)
+				}
+			})
+
+			ctx := context.Background()
+			popularity, _, err := client.Draws.Popularity(ctx, "51234")
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(popularity.DrawID).To(Equal("51234"))
+				Expect(popularity.Rows).To(Equal([]OutcomePopularity{
+					{RowID: "1", Home: 4532, Tie: 2711, Away: 2757},
+					{RowID: "2", Home: 1500, Tie: 3000, Away: 5500},
+				}))
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(popularity).To(BeNil())
+			}
+		},
+		Entry("should return the popularity of each row on happy-case", true, http.StatusOK, nil, drawPopularityResponseBytes),
+		Entry("should return error when draw is not found", false, http.StatusNotFound, &APIError{}, notFoundErrorBytes),
+	)
+	DescribeTable("Percentage.String",
+		func(percentage Percentage, expected string) {
+			Expect(percentage.String()).To(Equal(expected))
+		},
+		Entry("should format two decimals", Percentage(4532), "45.32 %"),
+		Entry("should pad the decimals", Percentage(705), "7.05 %"),
+	)
+})
@@ -0,0 +1,53 @@
+package goveikkaus
+
+import (
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// APIError is an error response of Veikkaus API with the status code, error code, field errors,
+// the request method and URL, and the raw response body. Match it with the sentinel errors below:
+//
+//	if errors.Is(err, goveikkaus.ErrDrawClosed) {
+//		...
+//	}
+type APIError = api.APIError
+
+type ErrorCode = api.ErrorCode
+
+// FieldError is the validation error of a single field of the request payload
+type FieldError = api.FieldError
+
+// Error types returned by the client, match them with errors.As:
+//
+//	var validationErr *goveikkaus.ValidationError
+//	if errors.As(err, &validationErr) {
+//		for _, fieldErr := range validationErr.FieldErrors {
+//			...
+//		}
+//	}
+type (
+	// UserNotLoggedInError is returned before the request when an authenticated call is made without a session
+	UserNotLoggedInError = api.UserNotLoggedInError
+	// UnauthorizedError is returned when Veikkaus API rejects the login or the session
+	UnauthorizedError = api.UnauthorizedError
+	// ValidationError is returned when Veikkaus API rejects the request payload
+	ValidationError = api.ValidationError
+	// UnsupportedStatusCodeError is returned for non-2xx responses which are not errors, e.g. redirects
+	UnsupportedStatusCodeError = api.UnsupportedStatusCodeError
+	// UnsupportedGameTypeError is returned before the request when the endpoint does not serve the game type
+	UnsupportedGameTypeError = api.UnsupportedGameTypeError
+	// RequestPayloadError is returned when the request payload cannot be encoded
+	RequestPayloadError = api.RequestPayloadError
+)
+
+// Sentinel errors to match API errors with errors.Is
+var (
+	ErrNotAuthenticated  = api.ErrNotAuthenticated
+	ErrValidation        = api.ErrValidation
+	ErrDrawClosed        = api.ErrDrawClosed
+	ErrInsufficientFunds = api.ErrInsufficientFunds
+	ErrRateLimited       = api.ErrRateLimited
+	ErrNotFound          = api.ErrNotFound
+	// ErrServiceUnavailable matches the maintenance breaks and the 503 responses of Veikkaus API
+	ErrServiceUnavailable = api.ErrServiceUnavailable
+)
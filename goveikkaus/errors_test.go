@@ -0,0 +1,83 @@
+package goveikkaus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var _ = Describe("errors", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	It("should return APIError with the request details matching the sentinel errors", func() {
+		endpoint := fmt.Sprintf(api.DrawEndpoint, GameTypeSport, "51234")
+		body := []byte(`{"code":"DRAW_CLOSED","fieldErrors":[]}`)
+		mux.HandleFunc("/"+endpoint, func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusConflict, body)
+		})
+
+		_, _, err := client.Draws.Get(context.Background(), GameTypeSport, "51234")
+
+		Expect(errors.Is(err, ErrDrawClosed)).To(BeTrue())
+		Expect(errors.Is(err, ErrNotAuthenticated)).To(BeFalse())
+
+		var apiErr *APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.StatusCode).To(Equal(http.StatusConflict))
+		Expect(apiErr.Method).To(Equal(http.MethodGet))
+		Expect(apiErr.URL).To(HaveSuffix(endpoint))
+		Expect(apiErr.Body).To(Equal(body))
+	})
+	It("should match wrapped validation and unauthorized errors", func() {
+		mux.HandleFunc("/"+api.WagerEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusBadRequest, wagerValidationErrorBytes)
+		})
+		mux.HandleFunc("/"+api.AccountBalanceEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusUnauthorized, notAuthenticatedBytes)
+		})
+		client.SessionTimeout = time.Now().Add(time.Hour)
+
+		_, _, err := client.Wager.Place(context.Background(), getSportWager())
+		Expect(errors.Is(err, ErrValidation)).To(BeTrue())
+
+		var apiErr *APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.StatusCode).To(Equal(http.StatusBadRequest))
+
+		_, _, err = client.Auth.AccountBalance(context.Background())
+		Expect(errors.Is(err, ErrNotAuthenticated)).To(BeTrue())
+	})
+	It("should return ErrServiceUnavailable for maintenance page", func() {
+		mux.HandleFunc("/"+fmt.Sprintf(api.DrawsEndpoint, GameTypeSport), func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			writeResponse(w, http.StatusServiceUnavailable, []byte("<html><body>Veikkaus.fi on huoltokatkolla</body></html>"))
+		})
+
+		draws, _, err := client.Draws.List(context.Background(), GameTypeSport)
+
+		Expect(draws).To(BeNil())
+		Expect(errors.Is(err, ErrServiceUnavailable)).To(BeTrue())
+
+		var apiErr *APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.ContentType).To(Equal("text/html; charset=utf-8"))
+		Expect(apiErr.BodySnippet()).To(Equal("<html><body>Veikkaus.fi on huoltokatkolla</body></html>"))
+	})
+})
@@ -11,7 +11,22 @@ type GameInfo struct {
 	Description string
 }
 
-type GameGlossary map[string]GameInfo
+// GameType is the game identifier used by Veikkaus API, e.g. in the draw and wager endpoints
+type GameType string
+
+const (
+	GameTypeFixedOdds  GameType = "FIXEDODDS"
+	GameTypeMultiScore GameType = "MULTISCORE"
+	GameTypeScore      GameType = "SCORE"
+	GameTypeSport      GameType = "SPORT"
+	GameTypeWinner     GameType = "WINNER"
+	GameTypePickTwo    GameType = "PICKTWO"
+	GameTypePickThree  GameType = "PICKTHREE"
+	GameTypePerfecta   GameType = "PERFECTA"
+	GameTypeTrifecta   GameType = "TRIFECTA"
+)
+
+type GameGlossary map[GameType]GameInfo
 
 func (glossary GameGlossary) Print() {
 	for key, gameInfo := range glossary {
@@ -25,7 +40,7 @@ func (glossary GameGlossary) Print() {
 
 func (g *GlossaryService) Get() GameGlossary {
 	gameGlossary := GameGlossary{
-		"FIXEDODDS": GameInfo{
+		GameTypeFixedOdds: GameInfo{
 			AlsoKnownAs: "Pitkäveto",
 			Description: `
 In fixed odds betting (Pitkäveto), you predict winners or outcomes for 1–20 matches. Stakes vary based on match count, sport, or time. Popular sports include soccer and ice hockey.
@@ -33,19 +48,19 @@ In fixed odds betting (Pitkäveto), you predict winners or outcomes for 1–20 m
 You can bet individually or use system betting for multiple combinations. Different bet types for the same match can't be combined, except for Build-a-bet. Odds may change before closing, and the recorded odds on the betting slip are final.
 			`,
 		},
-		"MULTISCORE": GameInfo{
+		GameTypeMultiScore: GameInfo{
 			AlsoKnownAs: "Moniveto",
 			Description: `
 Multi score (Moniveto) is a variable odds betting game based on the number of goals scored in 2–6 matches or other performance outcomes. Minimum bet ranges from 0.05 to 0.20 euros, with a maximum of 100 euros. Final odds can differ significantly from initial ones due to total bet sums influencing them post-game.
 			`,
 		},
-		"SCORE": GameInfo{
+		GameTypeScore: GameInfo{
 			AlsoKnownAs: "Tulosveto",
 			Description: `
 Result (or score) betting (Tulosveto) involves betting on the number of goals scored by both teams in the target match or other correct outcomes based on performance. Popular sports for result betting include soccer, ice hockey, basketball, and floorball. The bet ranges from 1.00 to 100.00 euros. Result betting is a variable odds game, where the odds are calculated after the game based on the total sum of bets placed on each outcome. Final odds may significantly differ from the initial ones.
 			`,
 		},
-		"SPORT": GameInfo{
+		GameTypeSport: GameInfo{
 			AlsoKnownAs: "Vakio",
 			Description: `
 In Vakio, you predict the winners of 6–18 matches in regular game time (1=home win, X=draw, 2=away win), or the outcome of a competition between two or three competitors. Winners are selected for each match.
@@ -55,7 +70,7 @@ Vakio 1 always has 13 match options, while other Vakios have 6–18 options. Pri
 Sports in Vakio mainly include soccer, ice hockey, Formula 1, and individual sports.
 			`,
 		},
-		"WINNER": GameInfo{
+		GameTypeWinner: GameInfo{
 			AlsoKnownAs: "Voittajaveto",
 			Description: `
 Win bet (Voittajaveto) involves betting on winners of events, specific correct result combinations, or outcome options. Popular sports include soccer, ice hockey, winter sports, and Formula 1. Bet ranges from 0.20 to 100.00 euros.
@@ -63,25 +78,25 @@ Win bet (Voittajaveto) involves betting on winners of events, specific correct r
 It's a variable odds game where the final odds may differ significantly from the purchase odds. Other forms include Perfecta, Trifecta, Daily Double, and Daily Triple.
 			`,
 		},
-		"PICKTWO": GameInfo{
+		GameTypePickTwo: GameInfo{
 			AlsoKnownAs: "Päivän pari",
 			Description: `
 In Daily Double aka "Pick two" (Päivän pari), the subject of the bet is the winners of two different competitions or specific defined result combinations or options.
 			`,
 		},
-		"PICKTHREE": GameInfo{
+		GameTypePickThree: GameInfo{
 			AlsoKnownAs: "Päivän trio",
 			Description: `
 In Daily Triple aka "Pick three" (Päivän trio), the subject of the bet is the winners of three different competitions or specific defined result combinations or options.
 			`,
 		},
-		"PERFECTA": GameInfo{
+		GameTypePerfecta: GameInfo{
 			AlsoKnownAs: "Superkaksari",
 			Description: `
 In Perfecta (Superkaksari), the subject of the bet is the winner of the competition and the competitor who finishes second in order of superiority.
 			`,
 		},
-		"TRIFECTA": GameInfo{
+		GameTypeTrifecta: GameInfo{
 			AlsoKnownAs: "Supertripla",
 			Description: `
 In Trifecta (Supertripla), the subject of the bet is the winner, the second-place finisher, and the third-place finisher in order of superiority.
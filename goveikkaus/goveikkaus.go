@@ -3,8 +3,11 @@ package goveikkaus
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 
@@ -32,12 +35,48 @@ type Client struct {
 	// User Agent to use when communicating with Veikkaus JSON API
 	UserAgent string
 
-	common         service
+	common service
+
+	sessionMu sync.Mutex
+	session   *Session
+
+	// SessionTimeout is the expiry time of the current authenticated session
 	SessionTimeout time.Time
+	// SessionStore persists the session after login so that it can be restored later, disabled when nil
+	SessionStore SessionStore
+
+	// CredentialsProvider enables automatic re-login when the session has expired, disabled when nil
+	CredentialsProvider CredentialsProvider
+	// OnReauthenticate is called after each automatic re-login attempt, it may use the client
+	OnReauthenticate func(ReauthEvent)
+	reauthMu         sync.Mutex
+
+	// RetryPolicy retries the failed idempotent requests, disabled when nil
+	RetryPolicy *RetryPolicy
+
+	// RateLimiter paces all requests of the client, disabled when nil
+	RateLimiter *RateLimiter
+	// EndpointRateLimiters pace the requests of each endpoint group in addition to RateLimiter
+	EndpointRateLimiters map[EndpointGroup]*RateLimiter
+
+	// Logger logs each request at debug level and the failed ones at warn level, disabled when nil.
+	// The session cookie is redacted from the logged headers.
+	Logger *slog.Logger
+
+	// Middleware wraps the sending of each request, the first middleware being the outermost one
+	Middleware []Middleware
+
+	// EnforcePlayerLimits makes WagerService.Place fetch the player's limits before each wager
+	// and refuse the wager locally with *LimitExceededError when it would exceed any of them
+	EnforcePlayerLimits bool
 
 	// Services used for interacting with different endpoints on Veikkaus API
+	Account  *AccountService
 	Auth     *AuthService
+	Draws    *DrawsService
 	Glossary *GlossaryService
+	Results  *ResultsService
+	Wager    *WagerService
 }
 
 func (veikkausClient *Client) UserIsLoggedIn() bool {
@@ -51,25 +90,28 @@ func (veikkausClient *Client) Client() *http.Client {
 	return &clientCopy
 }
 
+// NewClient returns a new client using a copy of the given HTTP client, or a default client when nil.
+// See New for configuring the client with options.
 func NewClient(httpClient *http.Client) *Client {
-	if httpClient == nil {
-		httpClient = &http.Client{
-			Jar: &api.RequestCookies{},
-		}
+	var opts []Option
+	if httpClient != nil {
+		opts = append(opts, WithHTTPClient(httpClient))
 	}
 
-	httpClient2 := *httpClient
-
-	veikkausClient := &Client{client: &httpClient2}
-	veikkausClient.initialize()
+	// New validates only the options, which are always valid here
+	veikkausClient, _ := New(opts...)
 
 	return veikkausClient
 }
 
+func newDefaultCookieJar() http.CookieJar {
+	return &api.CookieJar{}
+}
+
 func (veikkausClient *Client) initialize() {
 	if veikkausClient.client == nil {
 		veikkausClient.client = &http.Client{
-			Jar: &api.RequestCookies{},
+			Jar: newDefaultCookieJar(),
 		}
 	}
 	if veikkausClient.BaseURL == nil {
@@ -80,8 +122,40 @@ func (veikkausClient *Client) initialize() {
 	}
 
 	veikkausClient.common.apiClient = veikkausClient
+	veikkausClient.Account = (*AccountService)(&veikkausClient.common)
 	veikkausClient.Auth = (*AuthService)(&veikkausClient.common)
+	veikkausClient.Draws = (*DrawsService)(&veikkausClient.common)
 	veikkausClient.Glossary = (*GlossaryService)(&veikkausClient.common)
+	veikkausClient.Results = (*ResultsService)(&veikkausClient.common)
+	veikkausClient.Wager = (*WagerService)(&veikkausClient.common)
+}
+
+// NewRequest creates an API request for the given method and path. The path must be relative,
+// it is resolved against the client's BaseURL and the client's UserAgent is set to the request
+func (veikkausClient *Client) NewRequest(ctx context.Context, method, path string, body []byte) (*http.Request, error) {
+	if ctx == nil {
+		return nil, errNonNilContext
+	}
+
+	if !strings.HasSuffix(veikkausClient.BaseURL.Path, "/") {
+		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", veikkausClient.BaseURL)
+	}
+
+	requestURL, err := veikkausClient.BaseURL.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := api.NewRequest(requestURL.String(), method, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if veikkausClient.UserAgent != "" {
+		req.Header.Set("User-Agent", veikkausClient.UserAgent)
+	}
+
+	return api.WithContext(ctx, req), nil
 }
 
 func isContextOrURLError(ctx context.Context, err error) error {
@@ -108,16 +182,81 @@ func isAuthorizedCall(isAuthorizedCall []bool) bool {
 }
 
 func (veikkausClient *Client) do(ctx context.Context, req *http.Request, authorizedCall ...bool) (*http.Response, error) {
+	resp, err := veikkausClient.doWithReauth(ctx, req, authorizedCall...)
+
+	// The API no longer accepts the session and re-login was not possible or failed,
+	// so the local session state is stale as well
+	if isUnauthorizedError(err) {
+		veikkausClient.endSession()
+		return resp, veikkausClient.clearSessionStore(ctx, err)
+	}
+
+	return resp, err
+}
+
+// doWithReauth sends the request, logging in again with CredentialsProvider when the session has expired
+func (veikkausClient *Client) doWithReauth(ctx context.Context, req *http.Request, authorizedCall ...bool) (*http.Response, error) {
 	if ctx == nil {
 		return nil, errNonNilContext
 	}
 
+	requestStart := time.Now()
+
 	if isAuthorizedCall(authorizedCall) && !veikkausClient.UserIsLoggedIn() {
-		return nil, &api.UserNotLoggedInError{}
+		if !veikkausClient.canReauthenticate(ctx) {
+			return nil, &UserNotLoggedInError{}
+		}
+		if err := veikkausClient.reauthenticate(ctx, req, requestStart); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := veikkausClient.sendWithRetry(ctx, req, isAuthorizedCall(authorizedCall))
+
+	if isUnauthorizedError(err) && veikkausClient.canReauthenticate(ctx) {
+		replayReq := getReplayRequest(ctx, req)
+		if replayReq == nil {
+			return nil, err
+		}
+		if reauthErr := veikkausClient.reauthenticate(ctx, req, requestStart); reauthErr != nil {
+			return nil, reauthErr
+		}
+
+		return veikkausClient.sendWithRetry(withoutReauth(ctx), replayReq, isAuthorizedCall(authorizedCall))
 	}
 
+	return resp, err
+}
+
+// send sends the request once, and slides the session forward when the authorized call succeeds
+func (veikkausClient *Client) send(ctx context.Context, req *http.Request, authorizedCall bool) (*http.Response, error) {
 	req = api.WithContext(ctx, req)
 
+	if err := veikkausClient.waitForRateLimit(ctx, req); err != nil {
+		return nil, err
+	}
+
+	roundTrip := chainMiddleware(func(req *http.Request) (*http.Response, error) {
+		return veikkausClient.roundTrip(ctx, req)
+	}, veikkausClient.Middleware)
+
+	requestStart := time.Now()
+
+	resp, err := roundTrip(req)
+	veikkausClient.logRequest(ctx, req, resp, err, time.Since(requestStart))
+	if err != nil {
+		return nil, err
+	}
+
+	if authorizedCall {
+		veikkausClient.refreshSession(resp)
+	}
+
+	return resp, nil
+}
+
+// roundTrip sends the request with the HTTP client and parses the error response
+func (veikkausClient *Client) roundTrip(ctx context.Context, req *http.Request) (*http.Response, error) {
 	resp, err := veikkausClient.client.Do(req)
 	if err = isContextOrURLError(ctx, err); err != nil {
 		return nil, err
@@ -128,11 +267,16 @@ func (veikkausClient *Client) do(ctx context.Context, req *http.Request, authori
 		return nil, api.HandleError(resp)
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 func (veikkausClient *Client) Do(ctx context.Context, req *http.Request, responseInterface interface{}) (*http.Response, error) {
-	resp, err := veikkausClient.do(ctx, req)
+	return veikkausClient.doJSON(ctx, req, responseInterface)
+}
+
+// doJSON sends the request and decodes the JSON response body to responseInterface
+func (veikkausClient *Client) doJSON(ctx context.Context, req *http.Request, responseInterface interface{}, authorizedCall ...bool) (*http.Response, error) {
+	resp, err := veikkausClient.do(ctx, req, authorizedCall...)
 	if err != nil {
 		return resp, err
 	}
@@ -52,6 +52,59 @@ var _ = Describe("goveikkaus", func() {
 			Expect(newClient.client).NotTo(Equal(client))
 		})
 	})
+	Describe("NewRequest", func() {
+		It("should resolve the path against client's BaseURL and set the user agent", func() {
+			client.UserAgent = "my-robot/1.0"
+
+			req, err := client.NewRequest(context.Background(), http.MethodPost, api.LoginEndpoint, []byte(`{}`))
+
+			Expect(err).To(BeNil())
+			Expect(req.URL.String()).To(Equal(client.BaseURL.String() + api.LoginEndpoint))
+			Expect(req.Header.Get("User-Agent")).To(Equal("my-robot/1.0"))
+			Expect(req.Header.Get(api.RobotIdentifierHeaderKey)).To(Equal(api.RobotIdentifierHeaderValue))
+		})
+		It("should use per-client BaseURL without affecting other clients", func() {
+			otherClient := NewClient(nil)
+			otherClient.BaseURL, _ = url.Parse("http://localhost:1234/staging/")
+
+			req, err := otherClient.NewRequest(context.Background(), http.MethodGet, "foo", nil)
+			Expect(err).To(BeNil())
+			Expect(req.URL.String()).To(Equal("http://localhost:1234/staging/foo"))
+
+			req, err = client.NewRequest(context.Background(), http.MethodGet, "foo", nil)
+			Expect(err).To(BeNil())
+			Expect(req.URL.String()).To(Equal(serverURL + baseURLPath + "/foo"))
+		})
+		It("should bind the given context to the request", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			req, err := client.NewRequest(ctx, http.MethodGet, "foo", nil)
+
+			Expect(err).To(BeNil())
+			Expect(req.Context()).To(Equal(ctx))
+		})
+		It("returns error when BaseURL does not have a trailing slash", func() {
+			client.BaseURL, _ = url.Parse("http://localhost:1234/api")
+
+			req, err := client.NewRequest(context.Background(), http.MethodGet, "foo", nil)
+
+			Expect(req).To(BeNil())
+			Expect(err.Error()).To(ContainSubstring("trailing slash"))
+		})
+		It("returns error when nil context is passed", func() {
+			req, err := client.NewRequest(nil, http.MethodGet, "foo", nil) //lint:ignore SA1012 ignoring this for unit-test purposes
+
+			Expect(req).To(BeNil())
+			Expect(err).To(Equal(errNonNilContext))
+		})
+		It("returns error when request cannot be built", func() {
+			req, err := client.NewRequest(context.Background(), http.MethodPost, "foo", nil)
+
+			Expect(req).To(BeNil())
+			Expect(err).NotTo(BeNil())
+		})
+	})
 	Describe("isContextOrUrlError", func() {
 		It("returns context error when context.Done() detected", func() {
 			canceledCtx, cancel := context.WithCancel(context.Background())
@@ -94,7 +147,7 @@ var _ = Describe("goveikkaus", func() {
 				fmt.Fprint(w, expectedBody)
 			})
 
-			req, err := api.GetRequest("hello", "GET", nil)
+			req, err := client.NewRequest(context.Background(), http.MethodGet, "hello", nil)
 			Expect(err).To(BeNil())
 
 			ctx := context.Background()
@@ -113,7 +166,7 @@ var _ = Describe("goveikkaus", func() {
 		})
 		It("returns error when nil context is passed", func() {
 			// defer teardown()
-			req, _ := api.GetRequest("hello", "GET", nil)
+			req, _ := client.NewRequest(context.Background(), http.MethodGet, "hello", nil)
 			_, err := client.do(nil, req) //lint:ignore SA1012 ignoring this for unit-test purposes
 
 			Expect(err).NotTo(BeNil())
@@ -124,7 +177,7 @@ var _ = Describe("goveikkaus", func() {
 			// Immediately cancel the context
 			cancel()
 
-			req, _ := api.GetRequest("foobar", "GET", nil)
+			req, _ := client.NewRequest(context.Background(), http.MethodGet, "foobar", nil)
 			_, err := client.do(canceledCtx, req)
 
 			Expect(err).To(Equal(canceledCtx.Err()))
@@ -152,14 +205,14 @@ var _ = Describe("goveikkaus", func() {
 				fmt.Fprint(w, expectedBody)
 			})
 
-			req, err := api.GetRequest("foo", "GET", nil)
+			req, err := client.NewRequest(context.Background(), http.MethodGet, "foo", nil)
 			Expect(err).To(BeNil())
 
 			ctx := context.Background()
 			resp, err := client.do(ctx, req)
 
 			Expect(err).NotTo(BeNil())
-			Expect(err).To(BeAssignableToTypeOf(&api.UnsupportedStatusCodeError{}))
+			Expect(err).To(BeAssignableToTypeOf(&UnsupportedStatusCodeError{}))
 
 			Expect(resp).To(BeNil())
 		})
@@ -173,14 +226,14 @@ var _ = Describe("goveikkaus", func() {
 				}
 			})
 
-			req, err := api.GetRequest("youshallnotpass", "GET", nil)
+			req, err := client.NewRequest(context.Background(), http.MethodGet, "youshallnotpass", nil)
 			Expect(err).To(BeNil())
 
 			ctx := context.Background()
 			resp, err := client.do(ctx, req)
 
 			Expect(resp).To(BeNil())
-			Expect(err).To(BeAssignableToTypeOf(&api.UnauthorizedError{}))
+			Expect(err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
 		})
 		It("returns error when user is not logged in for authorized call", func() {
 			errorResponse := []byte(`{"code":"NOT_AUTHENTICATED", "fieldErrors":[]}`)
@@ -192,7 +245,7 @@ var _ = Describe("goveikkaus", func() {
 				}
 			})
 
-			req, err := api.GetRequest("youshallnotpass", "GET", nil)
+			req, err := client.NewRequest(context.Background(), http.MethodGet, "youshallnotpass", nil)
 			Expect(err).To(BeNil())
 
 			ctx := context.Background()
@@ -200,7 +253,7 @@ var _ = Describe("goveikkaus", func() {
 			resp, err := client.do(ctx, req, isAuthorized)
 
 			Expect(resp).To(BeNil())
-			Expect(err).To(BeAssignableToTypeOf(&api.UserNotLoggedInError{}))
+			Expect(err).To(BeAssignableToTypeOf(&UserNotLoggedInError{}))
 		})
 	})
 	Describe("Do", func() {
@@ -221,7 +274,7 @@ var _ = Describe("goveikkaus", func() {
 				}
 			})
 
-			req, err := api.GetRequest("hello", "GET", nil)
+			req, err := client.NewRequest(context.Background(), http.MethodGet, "hello", nil)
 			Expect(err).To(BeNil())
 
 			ctx := context.Background()
@@ -245,14 +298,14 @@ var _ = Describe("goveikkaus", func() {
 				}
 			})
 
-			req, err := api.GetRequest("youshallnotpass", "GET", nil)
+			req, err := client.NewRequest(context.Background(), http.MethodGet, "youshallnotpass", nil)
 			Expect(err).To(BeNil())
 
 			ctx := context.Background()
 			resp, err := client.Do(ctx, req, &v)
 
 			Expect(resp).To(BeNil())
-			Expect(err).To(BeAssignableToTypeOf(&api.UnauthorizedError{}))
+			Expect(err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
 		})
 	})
 	Describe("initialize", func() {
@@ -263,7 +316,7 @@ var _ = Describe("goveikkaus", func() {
 			// Assert that client is not-nil and has been initialized
 			Expect(client.client).NotTo(BeNil())
 			// Assert that the client's Jar is of the correct type
-			Expect(client.client.Jar).To(BeAssignableToTypeOf(&api.RequestCookies{}))
+			Expect(client.client.Jar).To(BeAssignableToTypeOf(&api.CookieJar{}))
 		})
 		It("should not overwrite clients Jar when client is nil", func() {
 			client := &http.Client{}
@@ -0,0 +1,107 @@
+package goveikkaus
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+const redacted = "[REDACTED]"
+
+// LogValue redacts the password when the payload is logged with log/slog
+func (p LoginPayload) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("type", p.Type),
+		slog.String("login", p.User),
+		slog.String("password", redacted),
+	)
+}
+
+// loggedHeader logs the header with the value of the session cookie redacted
+type loggedHeader http.Header
+
+func (h loggedHeader) LogValue() slog.Value {
+	attrs := make([]slog.Attr, 0, len(h))
+	for name, values := range h {
+		switch http.CanonicalHeaderKey(name) {
+		case "Cookie", "Set-Cookie":
+			values = redactSessionCookie(values)
+		}
+		attrs = append(attrs, slog.String(name, strings.Join(values, ", ")))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// redactSessionCookie replaces the value of the session cookie in Cookie and Set-Cookie header values
+func redactSessionCookie(values []string) []string {
+	redactedValues := make([]string, len(values))
+	for i, value := range values {
+		cookies := strings.Split(value, ";")
+		for j, cookie := range cookies {
+			name, _, found := strings.Cut(cookie, "=")
+			if found && strings.TrimSpace(name) == api.AuthSessionCookie {
+				cookies[j] = name + "=" + redacted
+			}
+		}
+		redactedValues[i] = strings.Join(cookies, ";")
+	}
+
+	return redactedValues
+}
+
+// logRequest logs the sent request at debug level, and the failed one at warn level
+func (veikkausClient *Client) logRequest(ctx context.Context, req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	logger := veikkausClient.Logger
+	if logger == nil {
+		return
+	}
+
+	level := slog.LevelDebug
+	if err != nil {
+		level = slog.LevelWarn
+	}
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+	}
+
+	var responseHeader http.Header
+	var apiErr *APIError
+	switch {
+	case errors.As(err, &apiErr):
+		attrs = append(attrs, slog.Int("status", apiErr.StatusCode))
+		if apiErr.Code != "" {
+			attrs = append(attrs, slog.String("code", string(apiErr.Code)))
+		}
+		responseHeader = apiErr.Header
+	case resp != nil:
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		responseHeader = resp.Header
+	}
+
+	attrs = append(attrs,
+		slog.Duration("duration", duration),
+		slog.Any("request_header", loggedHeader(req.Header)),
+	)
+	if responseHeader != nil {
+		attrs = append(attrs, slog.Any("response_header", loggedHeader(responseHeader)))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		logger.LogAttrs(ctx, level, "veikkaus request failed", attrs...)
+		return
+	}
+
+	logger.LogAttrs(ctx, level, "veikkaus request", attrs...)
+}
@@ -0,0 +1,122 @@
+package goveikkaus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// getLogRecords decodes the records written by slog.JSONHandler
+func getLogRecords(logs *bytes.Buffer) []map[string]interface{} {
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]interface{}
+		Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+		records = append(records, record)
+	}
+
+	return records
+}
+
+var _ = Describe("Logger", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+	var logs *bytes.Buffer
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+		logs = &bytes.Buffer{}
+		client.Logger = slog.New(slog.NewJSONHandler(logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	It("should log the successful request at debug level", func() {
+		endpoint := fmt.Sprintf(api.DrawsEndpoint, GameTypeSport)
+		mux.HandleFunc("/"+endpoint, func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusOK, drawListResponseBytes)
+		})
+
+		_, _, err := client.Draws.List(ctx, GameTypeSport)
+
+		Expect(err).To(BeNil())
+		records := getLogRecords(logs)
+		Expect(records).To(HaveLen(1))
+		Expect(records[0]).To(HaveKeyWithValue("level", "DEBUG"))
+		Expect(records[0]).To(HaveKeyWithValue("method", http.MethodGet))
+		Expect(records[0]).To(HaveKeyWithValue("path", "/api-v1/"+endpoint))
+		Expect(records[0]).To(HaveKeyWithValue("status", BeNumerically("==", http.StatusOK)))
+		Expect(records[0]).To(HaveKey("duration"))
+		Expect(records[0]).NotTo(HaveKey("code"))
+	})
+	It("should log the failed request at warn level with Veikkaus error code", func() {
+		mux.HandleFunc("/"+api.AccountBalanceEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusUnauthorized, notAuthenticatedBytes)
+		})
+		client.SessionTimeout = time.Now().Add(time.Hour)
+
+		_, _, err := client.Auth.AccountBalance(ctx)
+
+		Expect(err).To(MatchError(ErrNotAuthenticated))
+		records := getLogRecords(logs)
+		Expect(records).To(HaveLen(1))
+		Expect(records[0]).To(HaveKeyWithValue("level", "WARN"))
+		Expect(records[0]).To(HaveKeyWithValue("status", BeNumerically("==", http.StatusUnauthorized)))
+		Expect(records[0]).To(HaveKeyWithValue("code", string(api.NotAuthenticated)))
+		Expect(records[0]).To(HaveKey("error"))
+	})
+	It("should log only the failures when debug level is disabled", func() {
+		client.Logger = slog.New(slog.NewJSONHandler(logs, &slog.HandlerOptions{Level: slog.LevelWarn}))
+		endpoint := fmt.Sprintf(api.DrawsEndpoint, GameTypeSport)
+		mux.HandleFunc("/"+endpoint, func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusOK, drawListResponseBytes)
+		})
+
+		_, _, err := client.Draws.List(ctx, GameTypeSport)
+
+		Expect(err).To(BeNil())
+		Expect(logs.Len()).To(BeZero())
+	})
+	It("should redact the password and the session cookie", func() {
+		handleLogin(mux, &http.Cookie{Name: api.AuthSessionCookie, Value: "secret-session", Path: "/"})
+
+		_, _, err := client.Auth.Login(ctx, "username", "secret-password")
+
+		Expect(err).To(BeNil())
+		Expect(logs.String()).To(ContainSubstring(api.AuthSessionCookie + "=" + redacted))
+		Expect(logs.String()).NotTo(ContainSubstring("secret-session"))
+		Expect(logs.String()).NotTo(ContainSubstring("secret-password"))
+	})
+	It("should redact the password of logged LoginPayload", func() {
+		client.Logger.Info("login", "payload", LoginPayload{Type: "STANDARD_LOGIN", User: "username", Password: "secret-password"})
+
+		Expect(logs.String()).To(ContainSubstring(`"login":"username"`))
+		Expect(logs.String()).To(ContainSubstring(`"password":"` + redacted + `"`))
+		Expect(logs.String()).NotTo(ContainSubstring("secret-password"))
+	})
+	DescribeTable("redactSessionCookie",
+		func(values, expected []string) {
+			Expect(redactSessionCookie(values)).To(Equal(expected))
+		},
+		Entry("for Cookie header", []string{"a=1; JSESSIONID=abc; b=2"}, []string{"a=1; JSESSIONID=" + redacted + "; b=2"}),
+		Entry("for Set-Cookie header", []string{"JSESSIONID=abc; Path=/; HttpOnly"}, []string{"JSESSIONID=" + redacted + "; Path=/; HttpOnly"}),
+		Entry("for other cookies", []string{"other=abc"}, []string{"other=abc"}),
+	)
+})
@@ -0,0 +1,34 @@
+package goveikkaus
+
+import (
+	"errors"
+	"net/http"
+)
+
+// RoundTripFunc sends a single request to Veikkaus API. An error response is returned as a nil
+// response and the parsed error, which can be inspected with errors.As(err, &apiErr) for *APIError.
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// Middleware wraps the sending of each request, e.g. for auditing, metrics or signing the requests.
+// It runs after the headers of the request are set and before the response body is decoded. Each retry
+// and re-login replay of a request passes the chain again.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+var errNoResponse = errors.New("middleware returned neither a response nor an error")
+
+// chainMiddleware wraps the round trip so that the first middleware is the outermost one,
+// i.e. it sees the request first and the response last
+func chainMiddleware(roundTrip RoundTripFunc, middleware []Middleware) RoundTripFunc {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		roundTrip = middleware[i](roundTrip)
+	}
+
+	return func(req *http.Request) (*http.Response, error) {
+		resp, err := roundTrip(req)
+		if resp == nil && err == nil {
+			return nil, errNoResponse
+		}
+
+		return resp, err
+	}
+}
@@ -0,0 +1,170 @@
+package goveikkaus
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// recordingMiddleware appends the name to the calls before and after calling the next round trip
+func recordingMiddleware(name string, calls *[]string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			*calls = append(*calls, name+" before")
+			resp, err := next(req)
+			*calls = append(*calls, name+" after")
+			return resp, err
+		}
+	}
+}
+
+var _ = Describe("Middleware", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+	ctx := context.Background()
+	drawsEndpoint := fmt.Sprintf(api.DrawsEndpoint, GameTypeSport)
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	It("should call the first middleware as the outermost one", func() {
+		var calls []string
+		mux.HandleFunc("/"+drawsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, "server")
+			writeResponse(w, http.StatusOK, drawListResponseBytes)
+		})
+		client.Middleware = []Middleware{recordingMiddleware("first", &calls), recordingMiddleware("second", &calls)}
+
+		_, _, err := client.Draws.List(ctx, GameTypeSport)
+
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"first before", "second before", "server", "second after", "first after"}))
+	})
+	It("should send the headers set by the middleware on top of the client's headers", func() {
+		var header http.Header
+		mux.HandleFunc("/"+drawsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header
+			writeResponse(w, http.StatusOK, drawListResponseBytes)
+		})
+		client.Middleware = []Middleware{func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				Expect(req.Header.Get("User-Agent")).To(Equal(client.UserAgent))
+				req.Header.Set("X-Signature", "signed")
+				return next(req)
+			}
+		}}
+
+		_, _, err := client.Draws.List(ctx, GameTypeSport)
+
+		Expect(err).To(BeNil())
+		Expect(header.Get("X-Signature")).To(Equal("signed"))
+	})
+	It("should pass the parsed API error to the middleware", func() {
+		var apiErr *APIError
+		mux.HandleFunc("/"+drawsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusConflict, []byte(`{"code":"DRAW_CLOSED","fieldErrors":[]}`))
+		})
+		client.Middleware = []Middleware{func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				resp, err := next(req)
+				Expect(resp).To(BeNil())
+				Expect(errors.As(err, &apiErr)).To(BeTrue())
+				return resp, err
+			}
+		}}
+
+		_, _, err := client.Draws.List(ctx, GameTypeSport)
+
+		Expect(err).To(MatchError(ErrDrawClosed))
+		Expect(apiErr.StatusCode).To(Equal(http.StatusConflict))
+		Expect(apiErr.Code).To(Equal(api.DrawClosed))
+	})
+	It("should decode the response returned by the middleware without sending the request", func() {
+		var requests int32
+		mux.HandleFunc("/"+drawsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+		})
+		client.Middleware = []Middleware{func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+					Body:       io.NopCloser(bytes.NewReader(drawListResponseBytes)),
+					Request:    req,
+				}, nil
+			}
+		}}
+
+		draws, _, err := client.Draws.List(ctx, GameTypeSport)
+
+		Expect(err).To(BeNil())
+		Expect(draws).To(HaveLen(2))
+		Expect(atomic.LoadInt32(&requests)).To(BeZero())
+	})
+	It("should return an error when the middleware returns neither a response nor an error", func() {
+		client.Middleware = []Middleware{func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				return nil, nil
+			}
+		}}
+
+		_, _, err := client.Draws.List(ctx, GameTypeSport)
+
+		Expect(err).To(MatchError(errNoResponse))
+	})
+	It("should pass each retry through the middleware", func() {
+		var requests, calls int32
+		mux.HandleFunc("/"+drawsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) == 1 {
+				writeResponse(w, http.StatusServiceUnavailable, []byte(`<html>Service Unavailable</html>`))
+				return
+			}
+			writeResponse(w, http.StatusOK, drawListResponseBytes)
+		})
+		client.RetryPolicy = getTestRetryPolicy()
+		client.Middleware = []Middleware{func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				atomic.AddInt32(&calls, 1)
+				return next(req)
+			}
+		}}
+
+		_, _, err := client.Draws.List(ctx, GameTypeSport)
+
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(2)))
+	})
+	It("should keep the order of the middleware given with multiple options", func() {
+		var calls []string
+		optionClient, err := New(
+			WithMiddleware(recordingMiddleware("first", &calls)),
+			WithMiddleware(recordingMiddleware("second", &calls), recordingMiddleware("third", &calls)),
+		)
+
+		Expect(err).To(BeNil())
+		Expect(optionClient.Middleware).To(HaveLen(3))
+
+		roundTrip := chainMiddleware(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{}, nil
+		}, optionClient.Middleware)
+		_, err = roundTrip(&http.Request{})
+
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"first before", "second before", "third before", "third after", "second after", "first after"}))
+	})
+})
@@ -0,0 +1,127 @@
+package goveikkaus
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is the currency of the amounts sent by Veikkaus API without an explicit currency
+const DefaultCurrency = "EUR"
+
+var currencySymbols = map[string]string{
+	"EUR": "€",
+}
+
+// Money is an amount of money in cents, sent by Veikkaus API as an integer.
+// Arithmetic expects both amounts to be in the same currency.
+type Money struct {
+	Cents    int64
+	Currency string
+}
+
+// EuroCents returns the amount of euro cents as Money, e.g. EuroCents(150) is 1,50 €
+func EuroCents(cents int64) Money {
+	return Money{Cents: cents, Currency: DefaultCurrency}
+}
+
+func (m Money) currencyWith(other Money) string {
+	if m.Currency == "" {
+		return other.Currency
+	}
+
+	return m.Currency
+}
+
+func (m Money) Add(other Money) Money {
+	return Money{Cents: m.Cents + other.Cents, Currency: m.currencyWith(other)}
+}
+
+func (m Money) Sub(other Money) Money {
+	return Money{Cents: m.Cents - other.Cents, Currency: m.currencyWith(other)}
+}
+
+// Mul returns the amount multiplied by n, e.g. the cost of n row combinations with the stake m
+func (m Money) Mul(n int64) Money {
+	return Money{Cents: m.Cents * n, Currency: m.Currency}
+}
+
+func (m Money) Neg() Money {
+	return Money{Cents: -m.Cents, Currency: m.Currency}
+}
+
+func (m Money) IsZero() bool {
+	return m.Cents == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Cents < 0
+}
+
+// Cmp returns -1, 0 or +1 depending on whether m is less than, equal to or greater than other
+func (m Money) Cmp(other Money) int {
+	switch {
+	case m.Cents < other.Cents:
+		return -1
+	case m.Cents > other.Cents:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Float64 returns the amount in the main unit of the currency, e.g. euros
+func (m Money) Float64() float64 {
+	return float64(m.Cents) / 100
+}
+
+// String formats the amount the Finnish way, e.g. "1 234,56 €"
+func (m Money) String() string {
+	cents := m.Cents
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	units := strconv.FormatInt(cents/100, 10)
+
+	var grouped strings.Builder
+	for i, digit := range units {
+		if i > 0 && (len(units)-i)%3 == 0 {
+			grouped.WriteByte(' ')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	currency := m.Currency
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	if symbol, ok := currencySymbols[currency]; ok {
+		currency = symbol
+	}
+
+	return sign + grouped.String() + "," + strconv.FormatInt(cents%100+100, 10)[1:] + " " + currency
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	cents, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	m.Cents = cents
+	if m.Currency == "" {
+		m.Currency = DefaultCurrency
+	}
+
+	return nil
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(m.Cents, 10)), nil
+}
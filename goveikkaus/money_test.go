@@ -0,0 +1,63 @@
+package goveikkaus
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Money", func() {
+	DescribeTable("String",
+		func(money Money, expected string) {
+			Expect(money.String()).To(Equal(expected))
+		},
+		Entry("should format zero", EuroCents(0), "0,00 €"),
+		Entry("should format amounts under one euro", EuroCents(5), "0,05 €"),
+		Entry("should format amounts under hundred cents", EuroCents(99), "0,99 €"),
+		Entry("should format whole euros", EuroCents(100), "1,00 €"),
+		Entry("should group thousands with space", EuroCents(123456), "1 234,56 €"),
+		Entry("should group millions", EuroCents(123456789), "1 234 567,89 €"),
+		Entry("should format negative amounts", EuroCents(-123456), "-1 234,56 €"),
+		Entry("should use the currency code without a known symbol", Money{Cents: 150, Currency: "SEK"}, "1,50 SEK"),
+		Entry("should default to euros without currency", Money{Cents: 150}, "1,50 €"),
+	)
+	Describe("Arithmetic", func() {
+		It("should add, subtract and multiply amounts", func() {
+			Expect(EuroCents(150).Add(EuroCents(50))).To(Equal(EuroCents(200)))
+			Expect(EuroCents(150).Sub(EuroCents(200))).To(Equal(EuroCents(-50)))
+			Expect(EuroCents(10).Mul(3)).To(Equal(EuroCents(30)))
+			Expect(EuroCents(10).Neg()).To(Equal(EuroCents(-10)))
+			Expect(Money{}.Add(EuroCents(10))).To(Equal(EuroCents(10)))
+		})
+		It("should compare amounts", func() {
+			Expect(EuroCents(10).Cmp(EuroCents(20))).To(Equal(-1))
+			Expect(EuroCents(20).Cmp(EuroCents(20))).To(Equal(0))
+			Expect(EuroCents(30).Cmp(EuroCents(20))).To(Equal(1))
+			Expect(EuroCents(0).IsZero()).To(BeTrue())
+			Expect(EuroCents(-1).IsNegative()).To(BeTrue())
+			Expect(EuroCents(1575).Float64()).To(Equal(15.75))
+		})
+	})
+	Describe("JSON", func() {
+		It("should marshal and unmarshal cent integers", func() {
+			var money Money
+			Expect(json.Unmarshal([]byte(`1577`), &money)).To(Succeed())
+			Expect(money).To(Equal(EuroCents(1577)))
+
+			bytes, err := json.Marshal(money)
+			Expect(err).To(BeNil())
+			Expect(string(bytes)).To(Equal("1577"))
+		})
+		It("should return error for non-integer values", func() {
+			var money Money
+			Expect(json.Unmarshal([]byte(`15.77`), &money)).NotTo(Succeed())
+		})
+		It("should use the currency of the cash balance", func() {
+			var cash Balance
+			Expect(json.Unmarshal([]byte(`{"currency":"SEK","type":"CASH","balance":1577,"usableBalance":1500}`), &cash)).To(Succeed())
+			Expect(cash.Balance).To(Equal(Money{Cents: 1577, Currency: "SEK"}))
+			Expect(cash.UsableBalance).To(Equal(Money{Cents: 1500, Currency: "SEK"}))
+		})
+	})
+})
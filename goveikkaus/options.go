@@ -0,0 +1,250 @@
+package goveikkaus
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Option configures the client created with New
+type Option func(*clientOptions) error
+
+type clientOptions struct {
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	timeout     time.Duration
+	jar         http.CookieJar
+	baseURL     *url.URL
+	userAgent   string
+	retryPolicy *RetryPolicy
+	rateLimiter *RateLimiter
+
+	endpointRateLimiters map[EndpointGroup]*RateLimiter
+	sessionStore         SessionStore
+	logger               *slog.Logger
+	middleware           []Middleware
+}
+
+// New returns a new client configured with the options. The options are validated up front and
+// applied independent of their order, e.g. WithTimeout applies to the client given with WithHTTPClient.
+func New(opts ...Option) (*Client, error) {
+	options := &clientOptions{}
+	for _, opt := range opts {
+		if err := opt(options); err != nil {
+			return nil, err
+		}
+	}
+
+	httpClient := &http.Client{Jar: newDefaultCookieJar()}
+	if options.httpClient != nil {
+		clientCopy := *options.httpClient
+		httpClient = &clientCopy
+	}
+	if options.transport != nil {
+		httpClient.Transport = options.transport
+	}
+	if options.timeout > 0 {
+		httpClient.Timeout = options.timeout
+	}
+	if options.jar != nil {
+		httpClient.Jar = options.jar
+	}
+
+	veikkausClient := &Client{
+		client:               httpClient,
+		BaseURL:              options.baseURL,
+		UserAgent:            options.userAgent,
+		RetryPolicy:          options.retryPolicy,
+		RateLimiter:          options.rateLimiter,
+		EndpointRateLimiters: options.endpointRateLimiters,
+		SessionStore:         options.sessionStore,
+		Logger:               options.logger,
+		Middleware:           options.middleware,
+	}
+	veikkausClient.initialize()
+
+	return veikkausClient, nil
+}
+
+// WithBaseURL sets the base URL of Veikkaus API, it must be absolute and end with a trailing slash
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) error {
+		parsedURL, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("invalid base URL: %w", err)
+		}
+		if !parsedURL.IsAbs() {
+			return fmt.Errorf("base URL must be absolute, but %q is not", baseURL)
+		}
+		if !strings.HasSuffix(parsedURL.Path, "/") {
+			return fmt.Errorf("BaseURL must have a trailing slash, but %q does not", baseURL)
+		}
+
+		o.baseURL = parsedURL
+		return nil
+	}
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) error {
+		if userAgent == "" {
+			return errors.New("user agent must not be empty")
+		}
+
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for the requests, the client is copied
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) error {
+		if httpClient == nil {
+			return errors.New("HTTP client must not be nil")
+		}
+
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTransport sets the transport of the HTTP client
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *clientOptions) error {
+		if transport == nil {
+			return errors.New("transport must not be nil")
+		}
+
+		o.transport = transport
+		return nil
+	}
+}
+
+// WithTimeout sets the time limit of a single HTTP request, including reading the response body
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) error {
+		if timeout <= 0 {
+			return fmt.Errorf("timeout must be positive, got %s", timeout)
+		}
+
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithCookieJar sets the cookie jar holding the session cookie
+func WithCookieJar(jar http.CookieJar) Option {
+	return func(o *clientOptions) error {
+		if jar == nil {
+			return errors.New("cookie jar must not be nil")
+		}
+
+		o.jar = jar
+		return nil
+	}
+}
+
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *clientOptions) error {
+		if policy == nil {
+			return errors.New("retry policy must not be nil")
+		}
+		if policy.MaxAttempts < 1 {
+			return fmt.Errorf("retry policy must make at least 1 attempt, got %d", policy.MaxAttempts)
+		}
+		if policy.InitialBackoff < 0 || policy.MaxBackoff < 0 {
+			return errors.New("retry policy backoff must not be negative")
+		}
+		if policy.Jitter < 0 || policy.Jitter > 1 {
+			return fmt.Errorf("retry policy jitter must be between 0 and 1, got %v", policy.Jitter)
+		}
+
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithRateLimiter sets the limiter pacing all requests of the client, created with NewRateLimiter
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *clientOptions) error {
+		if err := validateRateLimiter(limiter); err != nil {
+			return err
+		}
+
+		o.rateLimiter = limiter
+		return nil
+	}
+}
+
+func validateRateLimiter(limiter *RateLimiter) error {
+	if limiter == nil {
+		return errors.New("rate limiter must not be nil")
+	}
+	if !(limiter.rate > 0) {
+		return errors.New("rate limiter must have a positive rate, create it with NewRateLimiter")
+	}
+
+	return nil
+}
+
+// WithEndpointRateLimiter sets the limiter pacing the requests of the endpoint group
+func WithEndpointRateLimiter(group EndpointGroup, limiter *RateLimiter) Option {
+	return func(o *clientOptions) error {
+		if err := validateRateLimiter(limiter); err != nil {
+			return err
+		}
+		if _, ok := endpointGroupPrefixes[group]; !ok {
+			return fmt.Errorf("unknown endpoint group %q", group)
+		}
+
+		if o.endpointRateLimiters == nil {
+			o.endpointRateLimiters = map[EndpointGroup]*RateLimiter{}
+		}
+		o.endpointRateLimiters[group] = limiter
+		return nil
+	}
+}
+
+// WithSessionStore sets the store the session is saved to after login. Call Client.RestoreSession
+// to resume a stored session.
+func WithSessionStore(store SessionStore) Option {
+	return func(o *clientOptions) error {
+		if store == nil {
+			return errors.New("session store must not be nil")
+		}
+
+		o.sessionStore = store
+		return nil
+	}
+}
+
+// WithLogger sets the logger of the requests, see Client.Logger
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *clientOptions) error {
+		if logger == nil {
+			return errors.New("logger must not be nil")
+		}
+
+		o.logger = logger
+		return nil
+	}
+}
+
+// WithMiddleware appends the middleware to the chain wrapping each request, see Middleware.
+// The middleware given first is the outermost one, also across multiple WithMiddleware options.
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(o *clientOptions) error {
+		for _, m := range middleware {
+			if m == nil {
+				return errors.New("middleware must not be nil")
+			}
+		}
+
+		o.middleware = append(o.middleware, middleware...)
+		return nil
+	}
+}
@@ -0,0 +1,96 @@
+package goveikkaus
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var _ = Describe("New", func() {
+	It("should return a client with the defaults without options", func() {
+		client, err := New()
+
+		Expect(err).To(BeNil())
+		Expect(client.BaseURL.String()).To(Equal(api.BaseURL))
+		Expect(client.UserAgent).To(Equal(api.UserAgent))
+		Expect(client.Client().Jar).To(BeAssignableToTypeOf(&api.CookieJar{}))
+		Expect(client.RetryPolicy).To(BeNil())
+		Expect(client.Draws).NotTo(BeNil())
+	})
+	It("should apply all the options", func() {
+		transport := &http.Transport{}
+		jar := &MockCookieJar{}
+		store := &MemorySessionStore{}
+		retryPolicy := DefaultRetryPolicy()
+		limiter := mustNewRateLimiter(1, 1)
+		drawsLimiter := mustNewRateLimiter(2, 1)
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+		client, err := New(
+			WithBaseURL("https://proxy.example.com/veikkaus/"),
+			WithUserAgent("my-robot"),
+			WithTransport(transport),
+			WithTimeout(5*time.Second),
+			WithCookieJar(jar),
+			WithRetryPolicy(retryPolicy),
+			WithRateLimiter(limiter),
+			WithEndpointRateLimiter(EndpointGroupDraws, drawsLimiter),
+			WithSessionStore(store),
+			WithLogger(logger),
+		)
+
+		Expect(err).To(BeNil())
+		Expect(client.BaseURL.String()).To(Equal("https://proxy.example.com/veikkaus/"))
+		Expect(client.UserAgent).To(Equal("my-robot"))
+		Expect(client.Client().Transport).To(BeIdenticalTo(transport))
+		Expect(client.Client().Timeout).To(Equal(5 * time.Second))
+		Expect(client.Client().Jar).To(BeIdenticalTo(jar))
+		Expect(client.RetryPolicy).To(BeIdenticalTo(retryPolicy))
+		Expect(client.RateLimiter).To(BeIdenticalTo(limiter))
+		Expect(client.EndpointRateLimiters).To(HaveKeyWithValue(EndpointGroupDraws, drawsLimiter))
+		Expect(client.SessionStore).To(BeIdenticalTo(store))
+		Expect(client.Logger).To(BeIdenticalTo(logger))
+	})
+	It("should apply the options to the given HTTP client independent of their order", func() {
+		httpClient := &http.Client{}
+
+		client, err := New(WithTimeout(time.Second), WithHTTPClient(httpClient))
+
+		Expect(err).To(BeNil())
+		Expect(client.Client().Timeout).To(Equal(time.Second))
+		Expect(httpClient.Timeout).To(BeZero())
+	})
+	DescribeTable("should validate the options",
+		func(opt Option, expectedErr string) {
+			client, err := New(opt)
+
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			Expect(client).To(BeNil())
+		},
+		Entry("for base URL without trailing slash", WithBaseURL("https://proxy.example.com/veikkaus"), "trailing slash"),
+		Entry("for relative base URL", WithBaseURL("veikkaus/"), "absolute"),
+		Entry("for unparseable base URL", WithBaseURL("://"), "invalid base URL"),
+		Entry("for empty user agent", WithUserAgent(""), "user agent"),
+		Entry("for nil HTTP client", WithHTTPClient(nil), "HTTP client"),
+		Entry("for nil transport", WithTransport(nil), "transport"),
+		Entry("for non-positive timeout", WithTimeout(0), "timeout"),
+		Entry("for nil cookie jar", WithCookieJar(nil), "cookie jar"),
+		Entry("for nil retry policy", WithRetryPolicy(nil), "retry policy"),
+		Entry("for retry policy without attempts", WithRetryPolicy(&RetryPolicy{}), "at least 1 attempt"),
+		Entry("for negative backoff", WithRetryPolicy(&RetryPolicy{MaxAttempts: 2, InitialBackoff: -time.Second}), "negative"),
+		Entry("for jitter out of range", WithRetryPolicy(&RetryPolicy{MaxAttempts: 2, Jitter: 1.5}), "jitter"),
+		Entry("for nil rate limiter", WithRateLimiter(nil), "rate limiter"),
+		Entry("for rate limiter without rate", WithRateLimiter(&RateLimiter{}), "positive rate"),
+		Entry("for endpoint rate limiter without rate", WithEndpointRateLimiter(EndpointGroupDraws, &RateLimiter{}), "positive rate"),
+		Entry("for unknown endpoint group", WithEndpointRateLimiter("lotto", mustNewRateLimiter(1, 1)), "unknown endpoint group"),
+		Entry("for nil session store", WithSessionStore(nil), "session store"),
+		Entry("for nil logger", WithLogger(nil), "logger"),
+		Entry("for nil middleware", WithMiddleware(nil), "middleware"),
+	)
+})
@@ -0,0 +1,130 @@
+package goveikkaus
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is the number of entries fetched per request by the pagers
+const DefaultPageSize = 50
+
+// page is a single page of a listing paged with offset and limit
+type page[T any] struct {
+	Total int `json:"total"`
+	Items []T `json:"items"`
+}
+
+// Pager iterates over a listing, fetching the next page from Veikkaus API when needed:
+//
+//	pager := client.Account.Transactions(nil)
+//	for pager.Next(ctx) {
+//		transaction := pager.Value()
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	apiClient *Client
+	endpoint  string
+	query     url.Values
+	pageSize  int
+
+	items    []T
+	current  T
+	offset   int
+	done     bool
+	err      error
+	response *http.Response
+}
+
+func newPager[T any](apiClient *Client, endpoint string, query url.Values, pageSize int) *Pager[T] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	return &Pager[T]{
+		apiClient: apiClient,
+		endpoint:  endpoint,
+		query:     query,
+		pageSize:  pageSize,
+	}
+}
+
+// Next advances to the next entry, and returns false when there are no more entries or fetching failed
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+
+	if len(p.items) == 0 && !p.done {
+		p.err = p.fetch(ctx)
+	}
+
+	if p.err != nil || len(p.items) == 0 {
+		return false
+	}
+
+	p.current = p.items[0]
+	p.items = p.items[1:]
+
+	return true
+}
+
+// Value returns the current entry
+func (p *Pager[T]) Value() T {
+	return p.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Response returns the response of the latest page request
+func (p *Pager[T]) Response() *http.Response {
+	return p.response
+}
+
+func (p *Pager[T]) fetch(ctx context.Context) error {
+	query := url.Values{}
+	for key, values := range p.query {
+		query[key] = values
+	}
+	query.Set("offset", strconv.Itoa(p.offset))
+	query.Set("limit", strconv.Itoa(p.pageSize))
+
+	req, err := p.apiClient.NewRequest(ctx, http.MethodGet, p.endpoint+"?"+query.Encode(), nil)
+
+	if err != nil {
+		return err
+	}
+
+	var result page[T]
+
+	resp, err := p.apiClient.doJSON(ctx, req, &result, true)
+	p.response = resp
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	p.items = result.Items
+	p.offset += len(result.Items)
+	p.done = isLastPage(len(result.Items), p.offset, result.Total)
+
+	return nil
+}
+
+// isLastPage reports whether the listing ends after the page. The total is used only when the response has it,
+// as the server may cap the limit below the page size, and otherwise the listing ends on an empty page.
+func isLastPage(itemCount, offset, total int) bool {
+	if itemCount == 0 {
+		return true
+	}
+
+	return total > 0 && offset >= total
+}
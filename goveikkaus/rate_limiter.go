@@ -0,0 +1,181 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// EndpointGroup groups the endpoints of Veikkaus API for rate limiting
+type EndpointGroup string
+
+const (
+	EndpointGroupAuth    EndpointGroup = "auth"
+	EndpointGroupAccount EndpointGroup = "account"
+	EndpointGroupDraws   EndpointGroup = "draws"
+	EndpointGroupWagers  EndpointGroup = "wagers"
+)
+
+// Endpoint path prefixes of each group, relative to Client.BaseURL
+var endpointGroupPrefixes = map[EndpointGroup][]string{
+	EndpointGroupAuth:    {api.LoginEndpoint},
+	EndpointGroupAccount: {"v1/players/self"},
+	EndpointGroupDraws:   {"sport-open-games/", "sport-odds/", "sport-popularity/"},
+	EndpointGroupWagers:  {"sport-interactive-wager/"},
+}
+
+// RateLimiterStats are the counters of a RateLimiter
+type RateLimiterStats struct {
+	// Requests is the number of requests let through
+	Requests int64
+	// Waits is the number of requests which had to wait, and WaitTime the total time they waited
+	Waits    int64
+	WaitTime time.Duration
+}
+
+// RateLimiter is a token bucket letting through Burst requests at once and Rate requests per second
+// on average. It is safe for concurrent use and can be shared between clients. Create it with NewRateLimiter,
+// the zero value does not limit requests.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	stats  RateLimiterStats
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+// NewRateLimiter returns a limiter for the given number of requests per second, starting with a full bucket.
+// Returns error if requestsPerSecond is not positive.
+func NewRateLimiter(requestsPerSecond float64, burst int) (*RateLimiter, error) {
+	if !(requestsPerSecond > 0) {
+		return nil, fmt.Errorf("rate limiter must have a positive rate, got %v", requestsPerSecond)
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}, nil
+}
+
+func (l *RateLimiter) currentTime() time.Time {
+	if l.now != nil {
+		return l.now()
+	}
+	return time.Now()
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait for it
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.currentTime()
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Wait blocks until the request is allowed or the context is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	wait := l.reserve()
+	if wait <= 0 {
+		l.record(0)
+		return nil
+	}
+
+	start := time.Now()
+	if err := sleep(ctx, wait); err != nil {
+		// Return the unused token to the bucket
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+
+	l.record(time.Since(start))
+
+	return nil
+}
+
+func (l *RateLimiter) record(waited time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stats.Requests++
+	if waited > 0 {
+		l.stats.Waits++
+		l.stats.WaitTime += waited
+	}
+}
+
+// Stats returns the counters of the limiter
+func (l *RateLimiter) Stats() RateLimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.stats
+}
+
+// getEndpointGroup returns the group of the request, or an empty group for endpoints not in any group
+func (veikkausClient *Client) getEndpointGroup(req *http.Request) EndpointGroup {
+	path := strings.TrimPrefix(req.URL.Path, veikkausClient.BaseURL.Path)
+
+	for group, prefixes := range endpointGroupPrefixes {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(path, prefix) {
+				return group
+			}
+		}
+	}
+
+	return ""
+}
+
+// waitForRateLimit waits for the client-wide limiter and then for the limiter of the request's endpoint group
+func (veikkausClient *Client) waitForRateLimit(ctx context.Context, req *http.Request) error {
+	if veikkausClient.RateLimiter != nil {
+		if err := veikkausClient.RateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(veikkausClient.EndpointRateLimiters) == 0 {
+		return nil
+	}
+
+	if limiter := veikkausClient.EndpointRateLimiters[veikkausClient.getEndpointGroup(req)]; limiter != nil {
+		return limiter.Wait(ctx)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package goveikkaus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// mustNewRateLimiter returns a limiter for the rate and burst which are known to be valid
+func mustNewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	limiter, err := NewRateLimiter(requestsPerSecond, burst)
+	if err != nil {
+		panic(err)
+	}
+	return limiter
+}
+
+var _ = Describe("RateLimiter", func() {
+	Describe("reserve", func() {
+		var limiter *RateLimiter
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Date(2024, 2, 4, 12, 0, 0, 0, time.UTC)
+			limiter = mustNewRateLimiter(2, 3)
+			limiter.now = func() time.Time { return now }
+		})
+
+		It("should let the burst through without waiting", func() {
+			for i := 0; i < 3; i++ {
+				Expect(limiter.reserve()).To(BeZero())
+			}
+			Expect(limiter.reserve()).To(Equal(500 * time.Millisecond))
+			Expect(limiter.reserve()).To(Equal(time.Second))
+		})
+		It("should refill the bucket with the rate up to the burst", func() {
+			for i := 0; i < 3; i++ {
+				limiter.reserve()
+			}
+
+			now = now.Add(time.Second)
+			Expect(limiter.reserve()).To(BeZero())
+			Expect(limiter.reserve()).To(BeZero())
+			Expect(limiter.reserve()).To(Equal(500 * time.Millisecond))
+
+			now = now.Add(time.Hour)
+			for i := 0; i < 3; i++ {
+				Expect(limiter.reserve()).To(BeZero())
+			}
+			Expect(limiter.reserve()).To(BeNumerically(">", 0))
+		})
+	})
+	DescribeTable("NewRateLimiter should return error for non-positive rate",
+		func(rate float64) {
+			limiter, err := NewRateLimiter(rate, 1)
+
+			Expect(limiter).To(BeNil())
+			Expect(err).To(MatchError(ContainSubstring("positive rate")))
+		},
+		Entry("zero", 0.0),
+		Entry("negative", -1.0),
+		Entry("NaN", math.NaN()),
+	)
+	Describe("Wait", func() {
+		It("should not limit requests with the zero value", func() {
+			limiter := &RateLimiter{}
+
+			for i := 0; i < 5; i++ {
+				Expect(limiter.Wait(context.Background())).To(Succeed())
+			}
+
+			Expect(limiter.Stats()).To(Equal(RateLimiterStats{Requests: 5}))
+		})
+		It("should pace the requests and record the time spent waiting", func() {
+			limiter := mustNewRateLimiter(50, 1)
+
+			start := time.Now()
+			for i := 0; i < 3; i++ {
+				Expect(limiter.Wait(context.Background())).To(Succeed())
+			}
+
+			Expect(time.Since(start)).To(BeNumerically(">=", 35*time.Millisecond))
+			stats := limiter.Stats()
+			Expect(stats.Requests).To(Equal(int64(3)))
+			Expect(stats.Waits).To(Equal(int64(2)))
+			Expect(stats.WaitTime).To(BeNumerically(">=", 35*time.Millisecond))
+		})
+		It("should stop waiting when the context is done and return the token", func() {
+			limiter := mustNewRateLimiter(1, 1)
+			Expect(limiter.Wait(context.Background())).To(Succeed())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			Expect(errors.Is(limiter.Wait(ctx), context.DeadlineExceeded)).To(BeTrue())
+			Expect(limiter.Stats().Requests).To(Equal(int64(1)))
+			Expect(limiter.reserve()).To(BeNumerically("<=", time.Second))
+		})
+	})
+	Describe("Client", func() {
+		var client *Client
+		var mux *http.ServeMux
+		var teardown func()
+
+		BeforeEach(func() {
+			client, mux, _, teardown = setup()
+			client.SessionTimeout = time.Now().Add(time.Hour)
+			mux.HandleFunc("/"+fmt.Sprintf(api.DrawsEndpoint, GameTypeSport), func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusOK, drawListResponseBytes)
+			})
+			mux.HandleFunc("/"+api.AccountBalanceEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusOK, []byte(`{"status":"ACTIVE","balances":{}}`))
+			})
+		})
+
+		AfterEach(func() {
+			defer teardown()
+		})
+
+		It("should wait for the client limiter before each request", func() {
+			client.RateLimiter = mustNewRateLimiter(1, 1)
+			Expect(client.RateLimiter.Wait(context.Background())).To(Succeed())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			_, _, err := client.Draws.List(ctx, GameTypeSport)
+
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+		It("should use the limiter of the endpoint group", func() {
+			drawsLimiter := mustNewRateLimiter(100, 5)
+			client.RateLimiter = mustNewRateLimiter(100, 5)
+			client.EndpointRateLimiters = map[EndpointGroup]*RateLimiter{EndpointGroupDraws: drawsLimiter}
+
+			_, _, err := client.Draws.List(context.Background(), GameTypeSport)
+			Expect(err).To(BeNil())
+			_, _, err = client.Auth.AccountBalance(context.Background())
+			Expect(err).To(BeNil())
+
+			Expect(drawsLimiter.Stats().Requests).To(Equal(int64(1)))
+			Expect(client.RateLimiter.Stats().Requests).To(Equal(int64(2)))
+		})
+	})
+	DescribeTable("getEndpointGroup",
+		func(endpoint string, expectedGroup EndpointGroup) {
+			client := NewClient(nil)
+			req, err := client.NewRequest(context.Background(), http.MethodGet, endpoint, nil)
+			Expect(err).To(BeNil())
+
+			Expect(client.getEndpointGroup(req)).To(Equal(expectedGroup))
+		},
+		Entry("should group login with auth", api.LoginEndpoint, EndpointGroupAuth),
+		Entry("should group account balance with account", api.AccountBalanceEndpoint, EndpointGroupAccount),
+		Entry("should group account history with account", api.AccountTransactionsEndpoint, EndpointGroupAccount),
+		Entry("should group draw odds with draws", fmt.Sprintf(api.DrawOddsEndpoint, GameTypeScore, "1"), EndpointGroupDraws),
+		Entry("should group draw results with draws", fmt.Sprintf(api.DrawResultEndpoint, GameTypeSport, "1"), EndpointGroupDraws),
+		Entry("should group tickets with wagers", fmt.Sprintf(api.TicketEndpoint, "1"), EndpointGroupWagers),
+		Entry("should not group unknown endpoints", "v2/unknown", EndpointGroup("")),
+	)
+})
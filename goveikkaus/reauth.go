@@ -0,0 +1,107 @@
+package goveikkaus
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// CredentialsProvider supplies the credentials used for logging in again when the session has expired
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (username, password string, err error)
+}
+
+// CredentialsProviderFunc is an adapter to use an ordinary function as CredentialsProvider
+type CredentialsProviderFunc func(ctx context.Context) (username, password string, err error)
+
+func (f CredentialsProviderFunc) Credentials(ctx context.Context) (string, string, error) {
+	return f(ctx)
+}
+
+// ReauthEvent describes an automatic re-login triggered by a request
+type ReauthEvent struct {
+	// Request that triggered the re-login
+	Request *http.Request
+	// Err is nil when the re-login succeeded
+	Err error
+}
+
+type reauthContextKey struct{}
+
+// withoutReauth marks the context so that requests made with it never trigger a re-login,
+// which guards against login loops
+func withoutReauth(ctx context.Context) context.Context {
+	return context.WithValue(ctx, reauthContextKey{}, true)
+}
+
+func (veikkausClient *Client) canReauthenticate(ctx context.Context) bool {
+	if veikkausClient.CredentialsProvider == nil {
+		return false
+	}
+
+	disabled, _ := ctx.Value(reauthContextKey{}).(bool)
+
+	return !disabled
+}
+
+func isUnauthorizedError(err error) bool {
+	var unauthorizedErr *UnauthorizedError
+	return errors.As(err, &unauthorizedErr)
+}
+
+// getReplayRequest returns a copy of the request with a fresh body, or nil when the body cannot be re-read.
+// The Cookie header added by http.Client from the jar on the earlier attempt is dropped, so that the replay
+// is sent only with the current cookies of the jar instead of the stale session cookie.
+func getReplayRequest(ctx context.Context, req *http.Request) *http.Request {
+	replayReq := req.Clone(ctx)
+	replayReq.Header.Del("Cookie")
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return replayReq
+	}
+
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	replayReq.Body = body
+
+	return replayReq
+}
+
+// reauthenticate logs in with the credentials from CredentialsProvider. Concurrent callers wait for a single
+// login, and the login is skipped when the session was already renewed after the request was started.
+func (veikkausClient *Client) reauthenticate(ctx context.Context, req *http.Request, requestStart time.Time) error {
+	veikkausClient.reauthMu.Lock()
+
+	if session := veikkausClient.Session(); session != nil && session.LoginTime.After(requestStart) && session.IsActive() {
+		veikkausClient.reauthMu.Unlock()
+		return nil
+	}
+
+	err := veikkausClient.login(ctx)
+	veikkausClient.reauthMu.Unlock()
+
+	// The hook is called without holding the lock so that it can use the client
+	if veikkausClient.OnReauthenticate != nil {
+		veikkausClient.OnReauthenticate(ReauthEvent{Request: req, Err: err})
+	}
+
+	return err
+}
+
+func (veikkausClient *Client) login(ctx context.Context) error {
+	username, password, err := veikkausClient.CredentialsProvider.Credentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = veikkausClient.Auth.Login(ctx, username, password)
+
+	return err
+}
@@ -0,0 +1,238 @@
+package goveikkaus
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var notAuthenticatedBytes = []byte(`{"code":"NOT_AUTHENTICATED", "fieldErrors":[]}`)
+
+func writeResponse(w http.ResponseWriter, statusCode int, body []byte) {
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(body); err != nil {
+		log.Fatalf("could not write response-body in unit-test: %v", err)
+	}
+}
+
+var _ = Describe("automatic re-login", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+	var loginCount atomic.Int32
+	var events []ReauthEvent
+
+	staticCredentials := CredentialsProviderFunc(func(ctx context.Context) (string, string, error) {
+		return "johndoe", "verysecret", nil
+	})
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+		loginCount.Store(0)
+		events = nil
+
+		client.CredentialsProvider = staticCredentials
+		client.OnReauthenticate = func(event ReauthEvent) {
+			events = append(events, event)
+		}
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	handleLoginWithStatus := func(statusCode int) {
+		mux.HandleFunc("/"+api.LoginEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			loginCount.Add(1)
+			if statusCode != http.StatusOK {
+				writeResponse(w, statusCode, notAuthenticatedBytes)
+				return
+			}
+			http.SetCookie(w, &http.Cookie{Name: api.AuthSessionCookie, Value: "fresh"})
+			writeResponse(w, statusCode, []byte(`{}`))
+		})
+	}
+
+	It("should log in before an authorized call when no session exists", func() {
+		handleLoginWithStatus(http.StatusOK)
+		mux.HandleFunc("/protected", func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusOK, []byte(`{}`))
+		})
+
+		req, _ := client.NewRequest(context.Background(), http.MethodGet, "protected", nil)
+		resp, err := client.do(context.Background(), req, true)
+
+		Expect(err).To(BeNil())
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(loginCount.Load()).To(Equal(int32(1)))
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Err).To(BeNil())
+		Expect(client.UserIsLoggedIn()).To(BeTrue())
+	})
+	It("should log in again and replay the request with its body when the session has expired", func() {
+		handleLoginWithStatus(http.StatusOK)
+		var calls atomic.Int32
+		mux.HandleFunc("/protected", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			Expect(string(body)).To(Equal(`{"foo":"bar"}`))
+
+			if calls.Add(1) == 1 {
+				writeResponse(w, http.StatusUnauthorized, notAuthenticatedBytes)
+				return
+			}
+			writeResponse(w, http.StatusOK, []byte(`{}`))
+		})
+
+		req, _ := client.NewRequest(context.Background(), http.MethodPost, "protected", []byte(`{"foo":"bar"}`))
+		resp, err := client.do(context.Background(), req)
+
+		Expect(err).To(BeNil())
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(calls.Load()).To(Equal(int32(2)))
+		Expect(loginCount.Load()).To(Equal(int32(1)))
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Request.URL.Path).To(HaveSuffix("/protected"))
+	})
+	It("should replay the request only with the fresh session cookie", func() {
+		mux.HandleFunc("/"+api.LoginEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			loginCount.Add(1)
+			http.SetCookie(w, &http.Cookie{Name: api.AuthSessionCookie, Value: "fresh", Path: "/"})
+			writeResponse(w, http.StatusOK, []byte(`{}`))
+		})
+		var replayedCookies []string
+		mux.HandleFunc("/protected", func(w http.ResponseWriter, r *http.Request) {
+			if cookie, err := r.Cookie(api.AuthSessionCookie); err != nil || cookie.Value != "fresh" {
+				writeResponse(w, http.StatusUnauthorized, notAuthenticatedBytes)
+				return
+			}
+			replayedCookies = r.Header.Values("Cookie")
+			writeResponse(w, http.StatusOK, []byte(`{}`))
+		})
+		client.Client().Jar.SetCookies(client.BaseURL, []*http.Cookie{{Name: api.AuthSessionCookie, Value: "expired", Path: "/"}})
+		client.SessionTimeout = time.Now().Add(time.Hour)
+
+		req, _ := client.NewRequest(context.Background(), http.MethodGet, "protected", nil)
+		resp, err := client.do(context.Background(), req, true)
+
+		Expect(err).To(BeNil())
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(loginCount.Load()).To(Equal(int32(1)))
+		Expect(replayedCookies).To(Equal([]string{api.AuthSessionCookie + "=fresh"}))
+	})
+	It("should allow the hook to use the client after a failed re-login", func() {
+		handleLoginWithStatus(http.StatusUnauthorized)
+		mux.HandleFunc("/protected", func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusOK, []byte(`{}`))
+		})
+		var hookErr error
+		client.OnReauthenticate = func(event ReauthEvent) {
+			events = append(events, event)
+			if len(events) > 1 {
+				return
+			}
+			req, _ := client.NewRequest(context.Background(), http.MethodGet, "protected", nil)
+			_, hookErr = client.do(context.Background(), req, true)
+		}
+
+		done := make(chan error)
+		go func() {
+			req, _ := client.NewRequest(context.Background(), http.MethodGet, "protected", nil)
+			_, err := client.do(context.Background(), req, true)
+			done <- err
+		}()
+
+		var err error
+		Eventually(done).WithTimeout(time.Second).Should(Receive(&err))
+		Expect(err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+		Expect(hookErr).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+		Expect(loginCount.Load()).To(Equal(int32(2)))
+	})
+	It("should re-login only once per request", func() {
+		handleLoginWithStatus(http.StatusOK)
+		mux.HandleFunc("/protected", func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusUnauthorized, notAuthenticatedBytes)
+		})
+
+		req, _ := client.NewRequest(context.Background(), http.MethodGet, "protected", nil)
+		resp, err := client.do(context.Background(), req)
+
+		Expect(resp).To(BeNil())
+		Expect(err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+		Expect(loginCount.Load()).To(Equal(int32(1)))
+		Expect(client.UserIsLoggedIn()).To(BeFalse())
+	})
+	It("should not loop when the login itself is rejected", func() {
+		handleLoginWithStatus(http.StatusUnauthorized)
+		mux.HandleFunc("/protected", func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusUnauthorized, notAuthenticatedBytes)
+		})
+
+		req, _ := client.NewRequest(context.Background(), http.MethodGet, "protected", nil)
+		resp, err := client.do(context.Background(), req)
+
+		Expect(resp).To(BeNil())
+		Expect(err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+		Expect(loginCount.Load()).To(Equal(int32(1)))
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+	})
+	It("should not re-login when a manual login fails", func() {
+		handleLoginWithStatus(http.StatusUnauthorized)
+
+		_, _, err := client.Auth.Login(context.Background(), "johndoe", "wrong")
+
+		Expect(err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+		Expect(loginCount.Load()).To(Equal(int32(1)))
+		Expect(events).To(BeEmpty())
+	})
+	It("should return the credentials provider error", func() {
+		providerErr := errors.New("vault is sealed")
+		client.CredentialsProvider = CredentialsProviderFunc(func(ctx context.Context) (string, string, error) {
+			return "", "", providerErr
+		})
+
+		req, _ := client.NewRequest(context.Background(), http.MethodGet, "protected", nil)
+		resp, err := client.do(context.Background(), req, true)
+
+		Expect(resp).To(BeNil())
+		Expect(err).To(Equal(providerErr))
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Err).To(Equal(providerErr))
+	})
+	It("should keep the original behavior without a credentials provider", func() {
+		client.CredentialsProvider = nil
+
+		req, _ := client.NewRequest(context.Background(), http.MethodGet, "protected", nil)
+		resp, err := client.do(context.Background(), req, true)
+
+		Expect(resp).To(BeNil())
+		Expect(err).To(BeAssignableToTypeOf(&UserNotLoggedInError{}))
+		Expect(loginCount.Load()).To(BeZero())
+	})
+	It("should not replay requests whose body cannot be re-read", func() {
+		handleLoginWithStatus(http.StatusOK)
+		mux.HandleFunc("/protected", func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusUnauthorized, notAuthenticatedBytes)
+		})
+
+		req, _ := client.NewRequest(context.Background(), http.MethodPost, "protected", []byte(`{}`))
+		req.Body = io.NopCloser(strings.NewReader(`{}`))
+		req.GetBody = nil
+
+		resp, err := client.do(context.Background(), req)
+
+		Expect(resp).To(BeNil())
+		Expect(err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+		Expect(loginCount.Load()).To(BeZero())
+	})
+})
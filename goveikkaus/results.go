@@ -0,0 +1,76 @@
+package goveikkaus
+
+// Service type: Results
+type ResultsService service
+
+// Response Types for ResultsService Endpoints
+
+// Score is the final score of a match
+type Score struct {
+	Home int `json:"home"`
+	Away int `json:"away"`
+}
+
+// RowResult is the result of a single row (event) of the draw. Sport (Vakio) games have the outcome
+// "1", "X" or "2", score games (Moniveto, Tulosveto) have the final score, and winner games have the
+// competitor numbers in their finishing order.
+type RowResult struct {
+	RowID       string `json:"rowId"`
+	Status      string `json:"status"`
+	Outcome     string `json:"outcome,omitempty"`
+	Score       *Score `json:"score,omitempty"`
+	Competitors []int  `json:"competitors,omitempty"`
+}
+
+type DrawResult struct {
+	DrawID   string      `json:"drawId"`
+	GameName GameType    `json:"gameName"`
+	Status   string      `json:"status"`
+	Rows     []RowResult `json:"rows"`
+}
+
+// CorrectRow returns the winning outcomes of a Vakio draw in the order of its rows
+func (r *DrawResult) CorrectRow() []string {
+	outcomes := make([]string, 0, len(r.Rows))
+	for _, row := range r.Rows {
+		outcomes = append(outcomes, row.Outcome)
+	}
+
+	return outcomes
+}
+
+// WinningScores returns the final scores of a Moniveto or Tulosveto draw in the order of its rows,
+// rows without a score (e.g. cancelled matches) are left out
+func (r *DrawResult) WinningScores() []Score {
+	scores := make([]Score, 0, len(r.Rows))
+	for _, row := range r.Rows {
+		if row.Score != nil {
+			scores = append(scores, *row.Score)
+		}
+	}
+
+	return scores
+}
+
+// WinShare is the prize of a single prize tier
+type WinShare struct {
+	Name string `json:"name"`
+	// HitCount is the number of correct selections needed for the tier, e.g. 13 in Vakio
+	HitCount int `json:"hitCount"`
+	// NumberOfBets is the number of winning bets on the tier
+	NumberOfBets int `json:"numberOfBets"`
+	// Value is the prize paid for a winning bet with the base stake
+	Value Money `json:"value"`
+}
+
+type DrawWinShares struct {
+	DrawID   string   `json:"drawId"`
+	GameName GameType `json:"gameName"`
+	// Jackpot is the amount added to the top prize tier from the earlier draws
+	Jackpot Money `json:"jackpot"`
+	// CarriedOver is the amount not won in this draw and carried over to the next one
+	CarriedOver Money      `json:"carriedOver"`
+	WinShares   []WinShare `json:"winShares"`
+}
+
+// End of Response Types for ResultsService Endpoints
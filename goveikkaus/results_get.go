@@ -0,0 +1,36 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// Get returns the final results of a closed draw, identified the same way as by DrawsService.List
+func (s *ResultsService) Get(ctx context.Context, gameType GameType, drawID string) (*DrawResult, *http.Response, error) {
+	if err := validateDrawGameType(gameType); err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf(api.DrawResultEndpoint, gameType, url.PathEscape(drawID))
+	req, err := s.apiClient.NewRequest(ctx, http.MethodGet, endpoint, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result DrawResult
+
+	resp, err := s.apiClient.Do(ctx, req, &result)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer resp.Body.Close()
+
+	return &result, resp, nil
+}
@@ -0,0 +1,84 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var sportResultResponseBytes = []byte(`{"drawId":"51234","gameName":"SPORT","status":"RESULTS_AVAILABLE","rows":[{"rowId":"1","status":"FINISHED","outcome":"1"},{"rowId":"2","status":"FINISHED","outcome":"X"},{"rowId":"3","status":"FINISHED","outcome":"2"}]}`)
+var multiScoreResultResponseBytes = []byte(`{"drawId":"98765","gameName":"MULTISCORE","status":"RESULTS_AVAILABLE","rows":[{"rowId":"1","status":"FINISHED","score":{"home":2,"away":1}},{"rowId":"2","status":"CANCELLED"}]}`)
+
+var _ = Describe("resultsservice: get", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	var notFoundErrorBytes = []byte(`{"code": "NOT_FOUND", "fieldErrors": []}`)
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("Get",
+		func(shouldSucceed bool, gameType GameType, drawID string, expectedStatusCode int, expectedErr error, expectedResponseBody []byte) {
+			mux.HandleFunc("/"+fmt.Sprintf(api.DrawResultEndpoint, gameType, drawID), func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(expectedStatusCode)
+				if _, err := w.Write(expectedResponseBody); err != nil {
+					log.Fatalf("Error while writing the response body in unit-test: %v", err)
+				}
+			})
+
+			ctx := context.Background()
+			result, _, err := client.Results.Get(ctx, gameType, drawID)
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(result.DrawID).To(Equal(drawID))
+				Expect(result.GameName).To(Equal(gameType))
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(result).To(BeNil())
+			}
+		},
+		Entry("should return the vakio result on happy-case", true, GameTypeSport, "51234", http.StatusOK, nil, sportResultResponseBytes),
+		Entry("should return the moniveto result on happy-case", true, GameTypeMultiScore, "98765", http.StatusOK, nil, multiScoreResultResponseBytes),
+		Entry("should return error when draw is not found", false, GameTypeSport, "51234", http.StatusNotFound, &APIError{}, notFoundErrorBytes),
+	)
+	Describe("Get", func() {
+		It("should return error for game types without draws", func() {
+			ctx := context.Background()
+			result, _, err := client.Results.Get(ctx, GameTypeFixedOdds, "1")
+
+			Expect(err).To(BeAssignableToTypeOf(&UnsupportedGameTypeError{}))
+			Expect(result).To(BeNil())
+		})
+		It("should return the correct row and the winning scores", func() {
+			mux.HandleFunc("/"+fmt.Sprintf(api.DrawResultEndpoint, GameTypeSport, "51234"), func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusOK, sportResultResponseBytes)
+			})
+			mux.HandleFunc("/"+fmt.Sprintf(api.DrawResultEndpoint, GameTypeMultiScore, "98765"), func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusOK, multiScoreResultResponseBytes)
+			})
+
+			ctx := context.Background()
+			sportResult, _, err := client.Results.Get(ctx, GameTypeSport, "51234")
+			Expect(err).To(BeNil())
+			Expect(sportResult.CorrectRow()).To(Equal([]string{"1", "X", "2"}))
+
+			multiScoreResult, _, err := client.Results.Get(ctx, GameTypeMultiScore, "98765")
+			Expect(err).To(BeNil())
+			Expect(multiScoreResult.WinningScores()).To(Equal([]Score{{Home: 2, Away: 1}}))
+		})
+	})
+})
@@ -0,0 +1,36 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// WinShares returns the prizes of each prize tier of a closed draw, including jackpot and carried-over amounts
+func (s *ResultsService) WinShares(ctx context.Context, gameType GameType, drawID string) (*DrawWinShares, *http.Response, error) {
+	if err := validateDrawGameType(gameType); err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf(api.DrawWinSharesEndpoint, gameType, url.PathEscape(drawID))
+	req, err := s.apiClient.NewRequest(ctx, http.MethodGet, endpoint, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var winShares DrawWinShares
+
+	resp, err := s.apiClient.Do(ctx, req, &winShares)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer resp.Body.Close()
+
+	return &winShares, resp, nil
+}
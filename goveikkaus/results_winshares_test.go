@@ -0,0 +1,70 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var winSharesResponseBytes = []byte(`{"drawId":"51234","gameName":"SPORT","jackpot":50000000,"carriedOver":1250000,"winShares":[{"name":"13 oikein","hitCount":13,"numberOfBets":0,"value":0},{"name":"12 oikein","hitCount":12,"numberOfBets":14,"value":215031}]}`)
+
+var _ = Describe("resultsservice: winshares", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	var notFoundErrorBytes = []byte(`{"code": "NOT_FOUND", "fieldErrors": []}`)
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("WinShares",
+		func(shouldSucceed bool, expectedStatusCode int, expectedErr error, expectedResponseBody []byte) {
+			mux.HandleFunc("/"+fmt.Sprintf(api.DrawWinSharesEndpoint, GameTypeSport, "51234"), func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(expectedStatusCode)
+				if _, err := w.Write(expectedResponseBody); err != nil {
+					log.Fatalf("Error while writing the response body in unit-test: %v", err)
+				}
+			})
+
+			ctx := context.Background()
+			winShares, _, err := client.Results.WinShares(ctx, GameTypeSport, "51234")
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(winShares.DrawID).To(Equal("51234"))
+				Expect(winShares.Jackpot).To(Equal(EuroCents(50000000)))
+				Expect(winShares.CarriedOver).To(Equal(EuroCents(1250000)))
+				Expect(winShares.WinShares).To(Equal([]WinShare{
+					{Name: "13 oikein", HitCount: 13, Value: EuroCents(0)},
+					{Name: "12 oikein", HitCount: 12, NumberOfBets: 14, Value: EuroCents(215031)},
+				}))
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(winShares).To(BeNil())
+			}
+		},
+		Entry("should return the win-shares on happy-case", true, http.StatusOK, nil, winSharesResponseBytes),
+		Entry("should return error when draw is not found", false, http.StatusNotFound, &APIError{}, notFoundErrorBytes),
+	)
+	Describe("WinShares", func() {
+		It("should return error for game types without draws", func() {
+			ctx := context.Background()
+			winShares, _, err := client.Results.WinShares(ctx, GameTypeFixedOdds, "1")
+
+			Expect(err).To(BeAssignableToTypeOf(&UnsupportedGameTypeError{}))
+			Expect(winShares).To(BeNil())
+		})
+	})
+})
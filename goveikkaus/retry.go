@@ -0,0 +1,184 @@
+package goveikkaus
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DefaultRetryableStatusCodes are the status codes retried when RetryPolicy.RetryableStatusCodes is nil
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy retries the failed requests with exponential backoff. By default only GET and HEAD
+// requests are retried, and placing a wager is never retried as it could buy the same ticket twice.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one, values below 2 disable retrying
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry, doubled for each following retry up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Jitter is the randomized fraction of the backoff, e.g. 0.2 waits 80-120 % of the backoff
+	Jitter float64
+
+	// RetryableStatusCodes are the error response status codes to retry, nil uses DefaultRetryableStatusCodes
+	RetryableStatusCodes []int
+	// RetryableError reports whether an error without a response, e.g. a connection reset, is retried.
+	// When nil, transport errors are retried except for context cancellation and deadline.
+	RetryableError func(error) bool
+	// Methods are the HTTP methods to retry, nil retries GET and HEAD only
+	Methods []string
+}
+
+// DefaultRetryPolicy returns a policy making 3 attempts with backoff starting from half a second
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Jitter:         0.2,
+	}
+}
+
+type retryContextKey struct{}
+
+// withoutRetry marks the context so that requests made with it are never retried
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryContextKey{}, true)
+}
+
+func (p *RetryPolicy) retriesMethod(method string) bool {
+	methods := p.Methods
+	if methods == nil {
+		methods = []string{http.MethodGet, http.MethodHead}
+	}
+
+	for _, retryMethod := range methods {
+		if method == retryMethod {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isTransportError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// isRetryable reports whether the error is retried, and returns the wait requested with Retry-After header
+func (p *RetryPolicy) isRetryable(err error) (bool, time.Duration) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		if p.RetryableError != nil {
+			return p.RetryableError(err), 0
+		}
+		return isTransportError(err), 0
+	}
+
+	statusCodes := p.RetryableStatusCodes
+	if statusCodes == nil {
+		statusCodes = DefaultRetryableStatusCodes
+	}
+
+	for _, statusCode := range statusCodes {
+		if apiErr.StatusCode == statusCode {
+			return true, getRetryAfter(apiErr.Header, time.Now())
+		}
+	}
+
+	return false, 0
+}
+
+// getBackoff returns the wait before the given retry, starting from 1
+func (p *RetryPolicy) getBackoff(retry int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	backoff := p.InitialBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || backoff < p.MaxBackoff); i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 {
+		backoff = time.Duration(float64(backoff) * (1 + p.Jitter*(2*rand.Float64()-1)))
+	}
+
+	return backoff
+}
+
+// getRetryAfter parses Retry-After header given either in seconds or as HTTP date, zero when not set
+func getRetryAfter(header http.Header, now time.Time) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// sendWithRetry sends the request, retrying it according to the client's RetryPolicy
+func (veikkausClient *Client) sendWithRetry(ctx context.Context, req *http.Request, authorizedCall bool) (*http.Response, error) {
+	policy := veikkausClient.RetryPolicy
+	if disabled, _ := ctx.Value(retryContextKey{}).(bool); disabled || policy == nil || !policy.retriesMethod(req.Method) {
+		return veikkausClient.send(ctx, req, authorizedCall)
+	}
+
+	attemptReq := req
+	for attempt := 1; ; attempt++ {
+		resp, err := veikkausClient.send(ctx, attemptReq, authorizedCall)
+		if err == nil || attempt >= policy.MaxAttempts {
+			return resp, err
+		}
+
+		retryable, retryAfter := policy.isRetryable(err)
+		if !retryable {
+			return resp, err
+		}
+
+		if attemptReq = getReplayRequest(ctx, req); attemptReq == nil {
+			return resp, err
+		}
+
+		if sleepErr := sleep(ctx, policy.getBackoff(attempt, retryAfter)); sleepErr != nil {
+			return nil, sleepErr
+		}
+	}
+}
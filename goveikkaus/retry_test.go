@@ -0,0 +1,218 @@
+package goveikkaus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+func getTestRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+}
+
+var _ = Describe("RetryPolicy", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+	var requests int32
+	var cookiesMu sync.Mutex
+	var cookieHeaders [][]string
+
+	// handleFailing responds with the status code to the first failures requests and then succeeds
+	handleFailing := func(endpoint string, failures int32, statusCode int, header http.Header) {
+		mux.HandleFunc("/"+endpoint, func(w http.ResponseWriter, r *http.Request) {
+			cookiesMu.Lock()
+			cookieHeaders = append(cookieHeaders, r.Header.Values("Cookie"))
+			cookiesMu.Unlock()
+
+			if atomic.AddInt32(&requests, 1) <= failures {
+				for key, values := range header {
+					w.Header()[key] = values
+				}
+				writeResponse(w, statusCode, []byte(`<html>Service Unavailable</html>`))
+				return
+			}
+			writeResponse(w, http.StatusOK, drawListResponseBytes)
+		})
+	}
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+		client.RetryPolicy = getTestRetryPolicy()
+		atomic.StoreInt32(&requests, 0)
+		cookieHeaders = nil
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("GET requests",
+		func(failures int32, statusCode int, shouldSucceed bool, expectedRequests int32) {
+			handleFailing(fmt.Sprintf(api.DrawsEndpoint, GameTypeSport), failures, statusCode, nil)
+			client.Client().Jar.SetCookies(client.BaseURL, []*http.Cookie{{Name: api.AuthSessionCookie, Value: "abc123", Path: "/"}})
+
+			draws, _, err := client.Draws.List(context.Background(), GameTypeSport)
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(draws).To(HaveLen(2))
+			} else {
+				Expect(err).To(HaveOccurred())
+				Expect(draws).To(BeNil())
+			}
+			Expect(atomic.LoadInt32(&requests)).To(Equal(expectedRequests))
+
+			cookiesMu.Lock()
+			defer cookiesMu.Unlock()
+			Expect(cookieHeaders).To(HaveLen(int(expectedRequests)))
+			for _, cookieHeader := range cookieHeaders {
+				Expect(cookieHeader).To(Equal([]string{api.AuthSessionCookie + "=abc123"}))
+			}
+		},
+		Entry("should succeed without retrying", int32(0), http.StatusServiceUnavailable, true, int32(1)),
+		Entry("should retry until the request succeeds", int32(2), http.StatusServiceUnavailable, true, int32(3)),
+		Entry("should give up after max attempts", int32(3), http.StatusBadGateway, false, int32(3)),
+		Entry("should not retry status codes which are not retryable", int32(1), http.StatusInternalServerError, false, int32(1)),
+	)
+	Describe("Retries", func() {
+		It("should not retry without a policy", func() {
+			client.RetryPolicy = nil
+			handleFailing(fmt.Sprintf(api.DrawsEndpoint, GameTypeSport), 1, http.StatusServiceUnavailable, nil)
+
+			_, _, err := client.Draws.List(context.Background(), GameTypeSport)
+
+			Expect(errors.Is(err, ErrServiceUnavailable)).To(BeTrue())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(1)))
+		})
+		It("should retry the configured status codes", func() {
+			client.RetryPolicy.RetryableStatusCodes = []int{http.StatusInternalServerError}
+			handleFailing(fmt.Sprintf(api.DrawsEndpoint, GameTypeSport), 1, http.StatusInternalServerError, nil)
+
+			_, _, err := client.Draws.List(context.Background(), GameTypeSport)
+
+			Expect(err).To(BeNil())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(2)))
+		})
+		It("should retry transport errors", func() {
+			mux.HandleFunc("/"+fmt.Sprintf(api.DrawsEndpoint, GameTypeSport), func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) == 1 {
+					hijacker, ok := w.(http.Hijacker)
+					Expect(ok).To(BeTrue())
+					conn, _, err := hijacker.Hijack()
+					Expect(err).To(BeNil())
+					conn.Close()
+					return
+				}
+				writeResponse(w, http.StatusOK, drawListResponseBytes)
+			})
+
+			_, _, err := client.Draws.List(context.Background(), GameTypeSport)
+
+			Expect(err).To(BeNil())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(2)))
+		})
+		It("should honor Retry-After header", func() {
+			client.RetryPolicy.MaxBackoff = time.Millisecond
+			handleFailing(fmt.Sprintf(api.DrawsEndpoint, GameTypeSport), 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}})
+
+			start := time.Now()
+			_, _, err := client.Draws.List(context.Background(), GameTypeSport)
+
+			Expect(err).To(BeNil())
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(2)))
+		})
+		It("should stop waiting when the context is cancelled", func() {
+			client.RetryPolicy.InitialBackoff = time.Minute
+			client.RetryPolicy.MaxBackoff = time.Minute
+			handleFailing(fmt.Sprintf(api.DrawsEndpoint, GameTypeSport), 1, http.StatusServiceUnavailable, nil)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			_, _, err := client.Draws.List(ctx, GameTypeSport)
+
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(1)))
+		})
+		It("should not retry POST requests by default", func() {
+			client.SessionTimeout = time.Now().Add(time.Hour)
+			handleFailing(api.WagerCheckEndpoint, 1, http.StatusServiceUnavailable, nil)
+
+			_, _, err := client.Wager.Check(context.Background(), getSportWager())
+
+			Expect(errors.Is(err, ErrServiceUnavailable)).To(BeTrue())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(1)))
+		})
+		It("should retry the configured methods with the request body", func() {
+			client.SessionTimeout = time.Now().Add(time.Hour)
+			client.RetryPolicy.Methods = []string{http.MethodPost}
+			mux.HandleFunc("/"+api.WagerCheckEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				var wager Wager
+				Expect(json.NewDecoder(r.Body).Decode(&wager)).To(Succeed())
+				Expect(wager).To(Equal(*getSportWager()))
+
+				if atomic.AddInt32(&requests, 1) == 1 {
+					writeResponse(w, http.StatusServiceUnavailable, nil)
+					return
+				}
+				writeResponse(w, http.StatusOK, []byte(`{"status":"OK","price":30}`))
+			})
+
+			_, _, err := client.Wager.Check(context.Background(), getSportWager())
+
+			Expect(err).To(BeNil())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(2)))
+		})
+		It("should never retry placing a wager", func() {
+			client.SessionTimeout = time.Now().Add(time.Hour)
+			client.RetryPolicy.Methods = []string{http.MethodPost}
+			handleFailing(api.WagerEndpoint, 1, http.StatusServiceUnavailable, nil)
+
+			_, _, err := client.Wager.Place(context.Background(), getSportWager())
+
+			Expect(errors.Is(err, ErrServiceUnavailable)).To(BeTrue())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(1)))
+		})
+	})
+	DescribeTable("getBackoff",
+		func(retry int, retryAfter, expectedBackoff time.Duration) {
+			policy := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+			Expect(policy.getBackoff(retry, retryAfter)).To(Equal(expectedBackoff))
+		},
+		Entry("should wait the initial backoff before the first retry", 1, time.Duration(0), 100*time.Millisecond),
+		Entry("should double the backoff for each retry", 3, time.Duration(0), 400*time.Millisecond),
+		Entry("should not exceed max backoff", 10, time.Duration(0), time.Second),
+		Entry("should wait as requested with Retry-After", 1, 5*time.Second, 5*time.Second),
+	)
+	Describe("getBackoff", func() {
+		It("should randomize the backoff with jitter", func() {
+			policy := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, Jitter: 0.2}
+			for i := 0; i < 100; i++ {
+				Expect(policy.getBackoff(1, 0)).To(BeNumerically("~", 100*time.Millisecond, 20*time.Millisecond))
+			}
+		})
+	})
+	DescribeTable("getRetryAfter",
+		func(value string, expected time.Duration) {
+			now := time.Date(2024, 2, 4, 12, 0, 0, 0, time.UTC)
+			Expect(getRetryAfter(http.Header{"Retry-After": []string{value}}, now)).To(Equal(expected))
+		},
+		Entry("should parse seconds", "120", 2*time.Minute),
+		Entry("should parse HTTP date", "Sun, 04 Feb 2024 12:00:30 GMT", 30*time.Second),
+		Entry("should ignore dates in the past", "Sun, 04 Feb 2024 11:00:00 GMT", time.Duration(0)),
+		Entry("should ignore invalid values", "soon", time.Duration(0)),
+		Entry("should return zero without the header", "", time.Duration(0)),
+	)
+})
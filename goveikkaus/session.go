@@ -0,0 +1,155 @@
+package goveikkaus
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// Session holds the state of an authenticated Veikkaus API session
+type Session struct {
+	// Cookie is the JSESSIONID cookie identifying the session, nil if the API did not return one
+	Cookie *http.Cookie
+
+	LoginTime    time.Time
+	LastActivity time.Time
+
+	// ExpiresAt is the expiry reported by the API, or estimated from the session length when not reported
+	ExpiresAt time.Time
+}
+
+func (s *Session) IsActive() bool {
+	return time.Now().Before(s.ExpiresAt)
+}
+
+// cookieJarClearer is implemented by cookie jars that can remove all of their cookies at once
+type cookieJarClearer interface {
+	Clear()
+}
+
+// clearCookieJar empties the jar, jars without Clear method get their cookies expired instead
+func clearCookieJar(jar http.CookieJar, u *url.URL) {
+	if clearer, ok := jar.(cookieJarClearer); ok {
+		clearer.Clear()
+		return
+	}
+
+	var expiredCookies []*http.Cookie
+	for _, cookie := range jar.Cookies(u) {
+		expiredCookies = append(expiredCookies, &http.Cookie{Name: cookie.Name, Path: "/", MaxAge: -1})
+	}
+	jar.SetCookies(u, expiredCookies)
+}
+
+func findSessionCookie(cookies []*http.Cookie) *http.Cookie {
+	for _, cookie := range cookies {
+		if cookie.Name == api.AuthSessionCookie {
+			return cookie
+		}
+	}
+
+	return nil
+}
+
+// getCookieExpiry returns the expiry the server set for the cookie, or zero time when none was set
+func getCookieExpiry(cookie *http.Cookie, now time.Time) time.Time {
+	if cookie == nil {
+		return time.Time{}
+	}
+
+	if cookie.MaxAge > 0 {
+		return now.Add(time.Duration(cookie.MaxAge) * time.Second)
+	}
+
+	return cookie.Expires
+}
+
+// Session returns a copy of the current authenticated session, or nil when there is none
+func (veikkausClient *Client) Session() *Session {
+	veikkausClient.sessionMu.Lock()
+	defer veikkausClient.sessionMu.Unlock()
+
+	if veikkausClient.session == nil {
+		return nil
+	}
+
+	sessionCopy := *veikkausClient.session
+	return &sessionCopy
+}
+
+func (veikkausClient *Client) startSession(resp *http.Response) {
+	now := time.Now()
+
+	cookie := findSessionCookie(resp.Cookies())
+	if cookie == nil && veikkausClient.client.Jar != nil {
+		cookie = findSessionCookie(veikkausClient.client.Jar.Cookies(veikkausClient.BaseURL))
+	}
+
+	expiresAt := getCookieExpiry(cookie, now)
+	if expiresAt.IsZero() {
+		expiresAt = getSessionTimeout()
+	}
+
+	veikkausClient.sessionMu.Lock()
+	defer veikkausClient.sessionMu.Unlock()
+
+	veikkausClient.session = &Session{
+		Cookie:       cookie,
+		LoginTime:    now,
+		LastActivity: now,
+		ExpiresAt:    expiresAt,
+	}
+	veikkausClient.SessionTimeout = expiresAt
+}
+
+// refreshSession slides the session expiry forward after a successful request made within the session
+func (veikkausClient *Client) refreshSession(resp *http.Response) {
+	veikkausClient.sessionMu.Lock()
+	defer veikkausClient.sessionMu.Unlock()
+
+	session := veikkausClient.session
+	if session == nil || !time.Now().Before(veikkausClient.SessionTimeout) {
+		return
+	}
+
+	now := time.Now()
+
+	if cookie := findSessionCookie(resp.Cookies()); cookie != nil {
+		session.Cookie = cookie
+	}
+
+	expiresAt := getCookieExpiry(session.Cookie, now)
+	if expiresAt.IsZero() || expiresAt.Before(now) {
+		expiresAt = getSessionTimeout()
+	}
+
+	session.LastActivity = now
+	session.ExpiresAt = expiresAt
+	veikkausClient.SessionTimeout = expiresAt
+}
+
+// hasSession reports whether the client holds a session, also when its local expiry estimate has passed
+func (veikkausClient *Client) hasSession() bool {
+	if veikkausClient.Session() != nil {
+		return true
+	}
+
+	jar := veikkausClient.client.Jar
+
+	return jar != nil && findSessionCookie(jar.Cookies(veikkausClient.BaseURL)) != nil
+}
+
+// endSession clears the session cookies and the local session state
+func (veikkausClient *Client) endSession() {
+	if jar := veikkausClient.client.Jar; jar != nil {
+		clearCookieJar(jar, veikkausClient.BaseURL)
+	}
+
+	veikkausClient.sessionMu.Lock()
+	defer veikkausClient.sessionMu.Unlock()
+
+	veikkausClient.session = nil
+	veikkausClient.SessionTimeout = time.Time{}
+}
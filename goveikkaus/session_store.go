@@ -0,0 +1,265 @@
+package goveikkaus
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// StoredSession is the session state persisted between program runs
+type StoredSession struct {
+	Cookie         *http.Cookie `json:"cookie"`
+	LoginTime      time.Time    `json:"loginTime"`
+	SessionTimeout time.Time    `json:"sessionTimeout"`
+}
+
+// SessionStore persists the authenticated session so that it can be reused by later program runs
+type SessionStore interface {
+	// Load returns the stored session, or nil when there is none
+	Load(ctx context.Context) (*StoredSession, error)
+	Save(ctx context.Context, session *StoredSession) error
+	Clear(ctx context.Context) error
+}
+
+// MemorySessionStore keeps the session in memory, e.g. for sharing a login between clients in the same process
+type MemorySessionStore struct {
+	mu      sync.Mutex
+	session *StoredSession
+}
+
+func copyStoredSession(session *StoredSession) *StoredSession {
+	sessionCopy := *session
+	if session.Cookie != nil {
+		cookieCopy := *session.Cookie
+		sessionCopy.Cookie = &cookieCopy
+	}
+	return &sessionCopy
+}
+
+func (m *MemorySessionStore) Load(ctx context.Context) (*StoredSession, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.session == nil {
+		return nil, nil
+	}
+
+	return copyStoredSession(m.session), nil
+}
+
+func (m *MemorySessionStore) Save(ctx context.Context, session *StoredSession) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.session = copyStoredSession(session)
+
+	return nil
+}
+
+func (m *MemorySessionStore) Clear(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.session = nil
+
+	return nil
+}
+
+// FileSessionStore keeps the session in a file as AES-GCM encrypted JSON
+type FileSessionStore struct {
+	path string
+	aead cipher.AEAD
+}
+
+// NewFileSessionStore returns a store writing to the given path. Key must be 16, 24 or 32 bytes long,
+// selecting AES-128, AES-192 or AES-256.
+func NewFileSessionStore(path string, key []byte) (*FileSessionStore, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileSessionStore{path: path, aead: aead}, nil
+}
+
+func (f *FileSessionStore) Load(ctx context.Context) (*StoredSession, error) {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := f.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, errors.New("session file is corrupted")
+	}
+
+	plaintext, err := f.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt session file: %w", err)
+	}
+
+	var session StoredSession
+	if err := json.Unmarshal(plaintext, &session); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (f *FileSessionStore) Save(ctx context.Context, session *StoredSession) error {
+	plaintext, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, f.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	data := f.aead.Seal(nonce, nonce, plaintext, nil)
+
+	// Write to a temporary file first so that a crash never leaves a half-written session behind
+	tmpFile, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), f.path)
+}
+
+func (f *FileSessionStore) Clear(ctx context.Context) error {
+	if err := os.Remove(f.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// NewClientWithSessionStore returns a new client which saves its session to the store after login,
+// and restores a previously saved session if it is still valid. The client is returned also when restoring
+// fails, e.g. the API is unavailable, together with the error and without a session so that it can log in normally.
+func NewClientWithSessionStore(ctx context.Context, httpClient *http.Client, store SessionStore) (*Client, error) {
+	veikkausClient := NewClient(httpClient)
+	veikkausClient.SessionStore = store
+
+	if _, err := veikkausClient.RestoreSession(ctx); err != nil {
+		veikkausClient.endSession()
+		return veikkausClient, err
+	}
+
+	return veikkausClient, nil
+}
+
+// SaveSession writes the current session to the client's SessionStore
+func (veikkausClient *Client) SaveSession(ctx context.Context) error {
+	session := veikkausClient.Session()
+	if veikkausClient.SessionStore == nil || session == nil || session.Cookie == nil {
+		return nil
+	}
+
+	return veikkausClient.SessionStore.Save(ctx, &StoredSession{
+		Cookie:         session.Cookie,
+		LoginTime:      session.LoginTime,
+		SessionTimeout: session.ExpiresAt,
+	})
+}
+
+// RestoreSession loads the session from the client's SessionStore and validates it with a cheap
+// authenticated call. Returns false when there was no usable session; an invalid session is removed from the store.
+func (veikkausClient *Client) RestoreSession(ctx context.Context) (bool, error) {
+	if veikkausClient.SessionStore == nil {
+		return false, nil
+	}
+
+	stored, err := veikkausClient.SessionStore.Load(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if stored == nil || stored.Cookie == nil || !time.Now().Before(stored.SessionTimeout) {
+		return false, veikkausClient.SessionStore.Clear(ctx)
+	}
+
+	veikkausClient.resumeSession(stored)
+
+	if _, _, err := veikkausClient.Auth.AccountBalance(withoutReauth(ctx)); err != nil {
+		veikkausClient.endSession()
+
+		if isUnauthorizedError(err) {
+			return false, veikkausClient.SessionStore.Clear(ctx)
+		}
+		return false, err
+	}
+
+	return true, veikkausClient.SaveSession(ctx)
+}
+
+func (veikkausClient *Client) resumeSession(stored *StoredSession) {
+	if veikkausClient.client.Jar != nil {
+		path := stored.Cookie.Path
+		if path == "" {
+			path = "/"
+		}
+
+		veikkausClient.client.Jar.SetCookies(veikkausClient.BaseURL, []*http.Cookie{{
+			Name:   api.AuthSessionCookie,
+			Value:  stored.Cookie.Value,
+			Path:   path,
+			Domain: stored.Cookie.Domain,
+		}})
+	}
+
+	veikkausClient.sessionMu.Lock()
+	defer veikkausClient.sessionMu.Unlock()
+
+	veikkausClient.session = &Session{
+		Cookie:       stored.Cookie,
+		LoginTime:    stored.LoginTime,
+		LastActivity: time.Now(),
+		ExpiresAt:    stored.SessionTimeout,
+	}
+	veikkausClient.SessionTimeout = stored.SessionTimeout
+}
+
+// clearSessionStore removes the stored session, err is returned as is unless clearing the store fails
+func (veikkausClient *Client) clearSessionStore(ctx context.Context, err error) error {
+	if veikkausClient.SessionStore == nil {
+		return err
+	}
+
+	if clearErr := veikkausClient.SessionStore.Clear(ctx); clearErr != nil {
+		return errors.Join(err, clearErr)
+	}
+
+	return err
+}
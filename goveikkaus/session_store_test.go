@@ -0,0 +1,277 @@
+package goveikkaus
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var sessionStoreKey = []byte("0123456789abcdef0123456789abcdef")
+
+func getStoredSession(timeout time.Time) *StoredSession {
+	return &StoredSession{
+		Cookie:         &http.Cookie{Name: api.AuthSessionCookie, Value: "stored-session"},
+		LoginTime:      time.Now().Add(-time.Minute).Truncate(time.Second),
+		SessionTimeout: timeout.Truncate(time.Second),
+	}
+}
+
+var _ = Describe("SessionStore", func() {
+	Describe("MemorySessionStore", func() {
+		It("should save, load and clear the session", func() {
+			ctx := context.Background()
+			store := &MemorySessionStore{}
+
+			loaded, err := store.Load(ctx)
+			Expect(err).To(BeNil())
+			Expect(loaded).To(BeNil())
+
+			stored := getStoredSession(time.Now().Add(time.Hour))
+			Expect(store.Save(ctx, stored)).To(Succeed())
+
+			stored.Cookie.Value = "mutated"
+			loaded, err = store.Load(ctx)
+			Expect(err).To(BeNil())
+			Expect(loaded.Cookie.Value).To(Equal("stored-session"))
+
+			Expect(store.Clear(ctx)).To(Succeed())
+			loaded, err = store.Load(ctx)
+			Expect(err).To(BeNil())
+			Expect(loaded).To(BeNil())
+		})
+	})
+	Describe("FileSessionStore", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "session.json")
+		})
+
+		It("should save the session encrypted and load it back", func() {
+			ctx := context.Background()
+			store, err := NewFileSessionStore(path, sessionStoreKey)
+			Expect(err).To(BeNil())
+
+			stored := getStoredSession(time.Now().Add(time.Hour))
+			Expect(store.Save(ctx, stored)).To(Succeed())
+
+			data, err := os.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(string(data)).NotTo(ContainSubstring("stored-session"))
+
+			info, err := os.Stat(path)
+			Expect(err).To(BeNil())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+
+			loaded, err := store.Load(ctx)
+			Expect(err).To(BeNil())
+			Expect(loaded.Cookie.Value).To(Equal("stored-session"))
+			Expect(loaded.SessionTimeout.Equal(stored.SessionTimeout)).To(BeTrue())
+			Expect(loaded.LoginTime.Equal(stored.LoginTime)).To(BeTrue())
+		})
+		It("should return nil when there is no session file", func() {
+			store, err := NewFileSessionStore(path, sessionStoreKey)
+			Expect(err).To(BeNil())
+
+			loaded, err := store.Load(context.Background())
+			Expect(err).To(BeNil())
+			Expect(loaded).To(BeNil())
+			Expect(store.Clear(context.Background())).To(Succeed())
+		})
+		It("should return error when the file was encrypted with another key", func() {
+			ctx := context.Background()
+			store, _ := NewFileSessionStore(path, sessionStoreKey)
+			Expect(store.Save(ctx, getStoredSession(time.Now().Add(time.Hour)))).To(Succeed())
+
+			otherStore, _ := NewFileSessionStore(path, []byte("fedcba9876543210fedcba9876543210"))
+			loaded, err := otherStore.Load(ctx)
+
+			Expect(loaded).To(BeNil())
+			Expect(err).NotTo(BeNil())
+		})
+		It("should return error when the file is corrupted", func() {
+			Expect(os.WriteFile(path, []byte("short"), 0o600)).To(Succeed())
+			store, _ := NewFileSessionStore(path, sessionStoreKey)
+
+			loaded, err := store.Load(context.Background())
+
+			Expect(loaded).To(BeNil())
+			Expect(err).NotTo(BeNil())
+		})
+		It("should return error for invalid key length", func() {
+			store, err := NewFileSessionStore(path, []byte("too short"))
+
+			Expect(store).To(BeNil())
+			Expect(err).NotTo(BeNil())
+		})
+	})
+	Describe("Client", func() {
+		var client *Client
+		var mux *http.ServeMux
+		var teardown func()
+		var store *MemorySessionStore
+		var balanceCalls atomic.Int32
+
+		BeforeEach(func() {
+			client, mux, _, teardown = setup()
+			store = &MemorySessionStore{}
+			client.SessionStore = store
+			balanceCalls.Store(0)
+		})
+
+		AfterEach(func() {
+			defer teardown()
+		})
+
+		handleAccountBalance := func(expectedCookie string) {
+			mux.HandleFunc("/"+api.AccountBalanceEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				balanceCalls.Add(1)
+				cookie, err := r.Cookie(api.AuthSessionCookie)
+				if err != nil || cookie.Value != expectedCookie {
+					writeResponse(w, http.StatusUnauthorized, notAuthenticatedBytes)
+					return
+				}
+				writeResponse(w, http.StatusOK, []byte(`{"status":"ACTIVE"}`))
+			})
+		}
+
+		It("should save the session after login and clear it after logout", func() {
+			ctx := context.Background()
+			mux.HandleFunc("/"+api.LoginEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					http.SetCookie(w, &http.Cookie{Name: api.AuthSessionCookie, Value: "abc123", Path: "/"})
+				}
+				writeResponse(w, http.StatusOK, []byte(`{}`))
+			})
+
+			_, _, err := client.Auth.Login(ctx, "johndoe", "verysecret")
+			Expect(err).To(BeNil())
+
+			stored, _ := store.Load(ctx)
+			Expect(stored.Cookie.Value).To(Equal("abc123"))
+			Expect(stored.SessionTimeout).To(Equal(client.SessionTimeout))
+
+			_, err = client.Auth.Logout(ctx)
+			Expect(err).To(BeNil())
+
+			stored, _ = store.Load(ctx)
+			Expect(stored).To(BeNil())
+		})
+		It("should restore a valid session from the store", func() {
+			ctx := context.Background()
+			handleAccountBalance("stored-session")
+			Expect(store.Save(ctx, getStoredSession(time.Now().Add(time.Hour)))).To(Succeed())
+
+			restored, err := client.RestoreSession(ctx)
+
+			Expect(err).To(BeNil())
+			Expect(restored).To(BeTrue())
+			Expect(balanceCalls.Load()).To(Equal(int32(1)))
+			Expect(client.UserIsLoggedIn()).To(BeTrue())
+			Expect(client.Session().Cookie.Value).To(Equal("stored-session"))
+		})
+		It("should discard a session the API no longer accepts", func() {
+			ctx := context.Background()
+			handleAccountBalance("another-session")
+			Expect(store.Save(ctx, getStoredSession(time.Now().Add(time.Hour)))).To(Succeed())
+
+			restored, err := client.RestoreSession(ctx)
+
+			Expect(err).To(BeNil())
+			Expect(restored).To(BeFalse())
+			Expect(client.UserIsLoggedIn()).To(BeFalse())
+			Expect(client.client.Jar.Cookies(client.BaseURL)).To(BeEmpty())
+
+			stored, _ := store.Load(ctx)
+			Expect(stored).To(BeNil())
+		})
+		It("should end the session but keep it in the store when the API is unavailable", func() {
+			ctx := context.Background()
+			mux.HandleFunc("/"+api.AccountBalanceEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusServiceUnavailable, []byte(`<html>Service Unavailable</html>`))
+			})
+			Expect(store.Save(ctx, getStoredSession(time.Now().Add(time.Hour)))).To(Succeed())
+
+			restored, err := client.RestoreSession(ctx)
+
+			Expect(err).To(MatchError(ErrServiceUnavailable))
+			Expect(restored).To(BeFalse())
+			Expect(client.UserIsLoggedIn()).To(BeFalse())
+			Expect(client.client.Jar.Cookies(client.BaseURL)).To(BeEmpty())
+
+			stored, _ := store.Load(ctx)
+			Expect(stored).NotTo(BeNil())
+		})
+		It("should restore the session cookie with its stored path", func() {
+			ctx := context.Background()
+			handleAccountBalance("stored-session")
+			storedSession := getStoredSession(time.Now().Add(time.Hour))
+			storedSession.Cookie.Path = client.BaseURL.Path
+			Expect(store.Save(ctx, storedSession)).To(Succeed())
+
+			restored, err := client.RestoreSession(ctx)
+			Expect(err).To(BeNil())
+			Expect(restored).To(BeTrue())
+
+			client.client.Jar.SetCookies(client.BaseURL, []*http.Cookie{{Name: api.AuthSessionCookie, Value: "renewed-session", Path: client.BaseURL.Path}})
+
+			cookies := client.client.Jar.Cookies(client.BaseURL)
+			Expect(cookies).To(HaveLen(1))
+			Expect(cookies[0].Value).To(Equal("renewed-session"))
+		})
+		It("should discard an expired session without calling the API", func() {
+			ctx := context.Background()
+			handleAccountBalance("stored-session")
+			Expect(store.Save(ctx, getStoredSession(time.Now().Add(-time.Minute)))).To(Succeed())
+
+			restored, err := client.RestoreSession(ctx)
+
+			Expect(err).To(BeNil())
+			Expect(restored).To(BeFalse())
+			Expect(balanceCalls.Load()).To(BeZero())
+
+			stored, _ := store.Load(ctx)
+			Expect(stored).To(BeNil())
+		})
+		It("should do nothing without a session store", func() {
+			client.SessionStore = nil
+
+			restored, err := client.RestoreSession(context.Background())
+
+			Expect(err).To(BeNil())
+			Expect(restored).To(BeFalse())
+			Expect(client.SaveSession(context.Background())).To(Succeed())
+		})
+	})
+	Describe("NewClientWithSessionStore", func() {
+		It("should return a client with the store and no session when store is empty", func() {
+			store := &MemorySessionStore{}
+
+			client, err := NewClientWithSessionStore(context.Background(), nil, store)
+
+			Expect(err).To(BeNil())
+			Expect(client.SessionStore).To(Equal(store))
+			Expect(client.UserIsLoggedIn()).To(BeFalse())
+		})
+		It("should return error with a client without session when the store cannot be read", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "session.json")
+			Expect(os.WriteFile(path, []byte("not encrypted at all, definitely"), 0o600)).To(Succeed())
+			store, _ := NewFileSessionStore(path, sessionStoreKey)
+
+			client, err := NewClientWithSessionStore(context.Background(), nil, store)
+
+			Expect(err).NotTo(BeNil())
+			Expect(client).NotTo(BeNil())
+			Expect(client.SessionStore).To(Equal(store))
+			Expect(client.UserIsLoggedIn()).To(BeFalse())
+		})
+	})
+})
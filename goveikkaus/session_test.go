@@ -0,0 +1,183 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+func handleLogin(mux *http.ServeMux, sessionCookie *http.Cookie) {
+	mux.HandleFunc("/"+api.LoginEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		if sessionCookie != nil {
+			http.SetCookie(w, sessionCookie)
+		}
+		if _, err := w.Write([]byte(`{}`)); err != nil {
+			log.Fatalf("Error while writing the response body in test: %v", err)
+		}
+	})
+}
+
+var _ = Describe("Session", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	It("should not have a session before login", func() {
+		Expect(client.Session()).To(BeNil())
+	})
+	It("should store the session cookie and server-reported expiry on login", func() {
+		handleLogin(mux, &http.Cookie{Name: api.AuthSessionCookie, Value: "abc123", MaxAge: 600})
+
+		before := time.Now()
+		_, _, err := client.Auth.Login(context.Background(), "johndoe", "verysecret")
+		Expect(err).To(BeNil())
+
+		session := client.Session()
+		Expect(session).NotTo(BeNil())
+		Expect(session.Cookie.Value).To(Equal("abc123"))
+		Expect(session.LoginTime).To(BeTemporally(">=", before))
+		Expect(session.LastActivity).To(Equal(session.LoginTime))
+		Expect(session.ExpiresAt).To(BeTemporally("~", before.Add(600*time.Second), time.Second))
+		Expect(client.SessionTimeout).To(Equal(session.ExpiresAt))
+		Expect(session.IsActive()).To(BeTrue())
+		Expect(client.UserIsLoggedIn()).To(BeTrue())
+	})
+	It("should estimate the expiry from the session length when the API does not report it", func() {
+		handleLogin(mux, nil)
+
+		_, _, err := client.Auth.Login(context.Background(), "johndoe", "verysecret")
+		Expect(err).To(BeNil())
+
+		session := client.Session()
+		Expect(session.Cookie).To(BeNil())
+		Expect(session.ExpiresAt).To(BeTemporally("~", getSessionTimeout(), time.Second))
+	})
+	It("should return a copy of the session", func() {
+		handleLogin(mux, nil)
+
+		_, _, err := client.Auth.Login(context.Background(), "johndoe", "verysecret")
+		Expect(err).To(BeNil())
+
+		session := client.Session()
+		session.ExpiresAt = time.Time{}
+
+		Expect(client.Session().ExpiresAt.IsZero()).To(BeFalse())
+	})
+	It("should slide the session forward on successful requests", func() {
+		handleLogin(mux, &http.Cookie{Name: api.AuthSessionCookie, Value: "abc123", MaxAge: 600})
+		mux.HandleFunc("/"+api.AccountBalanceEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := w.Write([]byte(`{"status":"ACTIVE"}`)); err != nil {
+				log.Fatalf("Error while writing the response body in test: %v", err)
+			}
+		})
+
+		ctx := context.Background()
+		_, _, err := client.Auth.Login(ctx, "johndoe", "verysecret")
+		Expect(err).To(BeNil())
+		loginSession := client.Session()
+
+		time.Sleep(10 * time.Millisecond)
+
+		_, _, err = client.Auth.AccountBalance(ctx)
+		Expect(err).To(BeNil())
+
+		session := client.Session()
+		Expect(session.LoginTime).To(Equal(loginSession.LoginTime))
+		Expect(session.LastActivity).To(BeTemporally(">", loginSession.LastActivity))
+		Expect(session.ExpiresAt).To(BeTemporally(">", loginSession.ExpiresAt))
+		Expect(client.SessionTimeout).To(Equal(session.ExpiresAt))
+	})
+	It("should not revive an expired session", func() {
+		handleLogin(mux, nil)
+		var balanceCalls atomic.Int32
+		mux.HandleFunc("/"+api.AccountBalanceEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			balanceCalls.Add(1)
+			if _, err := w.Write([]byte(`{"status":"ACTIVE"}`)); err != nil {
+				log.Fatalf("Error while writing the response body in test: %v", err)
+			}
+		})
+
+		ctx := context.Background()
+		_, _, err := client.Auth.Login(ctx, "johndoe", "verysecret")
+		Expect(err).To(BeNil())
+
+		expired := time.Now().Add(-time.Minute)
+		client.SessionTimeout = expired
+
+		_, _, err = client.Auth.AccountBalance(ctx)
+		Expect(err).To(BeAssignableToTypeOf(&UserNotLoggedInError{}))
+
+		Expect(balanceCalls.Load()).To(BeZero())
+		Expect(client.SessionTimeout).To(Equal(expired))
+		Expect(client.UserIsLoggedIn()).To(BeFalse())
+	})
+	It("should end the session when the API rejects it", func() {
+		handleLogin(mux, &http.Cookie{Name: api.AuthSessionCookie, Value: "abc123", Path: "/"})
+		mux.HandleFunc("/"+api.AccountLimitsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusUnauthorized, notAuthenticatedBytes)
+		})
+		store := &MemorySessionStore{}
+		client.SessionStore = store
+
+		ctx := context.Background()
+		_, _, err := client.Auth.Login(ctx, "johndoe", "verysecret")
+		Expect(err).To(BeNil())
+		Expect(client.UserIsLoggedIn()).To(BeTrue())
+
+		_, _, err = client.Account.Limits(ctx)
+
+		Expect(err).To(BeAssignableToTypeOf(&UnauthorizedError{}))
+		Expect(client.UserIsLoggedIn()).To(BeFalse())
+		Expect(client.Session()).To(BeNil())
+		Expect(client.Client().Jar.Cookies(client.BaseURL)).To(BeEmpty())
+
+		stored, _ := store.Load(ctx)
+		Expect(stored).To(BeNil())
+	})
+	It("should not extend the session on requests to public endpoints", func() {
+		handleLogin(mux, nil)
+		mux.HandleFunc("/"+fmt.Sprintf(api.DrawsEndpoint, GameTypeSport), func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(w, http.StatusOK, drawListResponseBytes)
+		})
+
+		ctx := context.Background()
+		_, _, err := client.Auth.Login(ctx, "johndoe", "verysecret")
+		Expect(err).To(BeNil())
+
+		expiresSoon := time.Now().Add(time.Second)
+		client.SessionTimeout = expiresSoon
+		loginSession := client.Session()
+
+		_, _, err = client.Draws.List(ctx, GameTypeSport)
+		Expect(err).To(BeNil())
+
+		Expect(client.SessionTimeout).To(Equal(expiresSoon))
+		Expect(client.Session().LastActivity).To(Equal(loginSession.LastActivity))
+	})
+	DescribeTable("getCookieExpiry",
+		func(cookie *http.Cookie, expected time.Time) {
+			now := time.Unix(1700000000, 0)
+			Expect(getCookieExpiry(cookie, now)).To(Equal(expected))
+		},
+		Entry("should return zero time for nil cookie", nil, time.Time{}),
+		Entry("should prefer Max-Age over Expires", &http.Cookie{MaxAge: 60, Expires: time.Unix(1, 0)}, time.Unix(1700000060, 0)),
+		Entry("should return Expires when Max-Age is not set", &http.Cookie{Expires: time.Unix(1800000000, 0)}, time.Unix(1800000000, 0)),
+		Entry("should return zero time for session cookies", &http.Cookie{}, time.Time{}),
+	)
+})
@@ -6,8 +6,6 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"os"
-
-	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
 )
 
 const (
@@ -32,9 +30,6 @@ func setup() (client *Client, mux *http.ServeMux, serverURL string, teardown fun
 	server := httptest.NewServer(apiHandler)
 	url, _ := url.Parse(server.URL + baseURLPath + "/")
 
-	// Overwrite BaseURL variable on internal/veikkausapi
-	api.BaseURL = url.String()
-
 	client = NewClient(nil)
 	client.BaseURL = url
 
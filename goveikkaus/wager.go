@@ -0,0 +1,202 @@
+package goveikkaus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// Service type: Wager
+type WagerService service
+
+type BetType string
+
+const (
+	// BetTypeSingle row has exactly one selection per event
+	BetTypeSingle BetType = "REGULAR"
+	// BetTypeSystem row has multiple selections per event and is played as all of their combinations
+	BetTypeSystem BetType = "SYSTEM"
+)
+
+// Request Payload Types for WagerService Endpoints
+
+// WagerSelection is the selection for a single event (row of the draw). Sport (Vakio) games use outcomes
+// "1", "X" and "2", score games (Moniveto, Tulosveto) use home and away scores, and winner games use
+// competitor numbers.
+type WagerSelection struct {
+	Outcomes    []string `json:"outcomes,omitempty"`
+	HomeScores  []int    `json:"homeScores,omitempty"`
+	AwayScores  []int    `json:"awayScores,omitempty"`
+	Competitors []int    `json:"competitors,omitempty"`
+}
+
+// WagerRow is a single or a system row of the wager, with one selection per event of the draw
+type WagerRow struct {
+	BetType BetType `json:"betType"`
+	// Stake of a single row combination
+	Stake      Money            `json:"stake"`
+	Selections []WagerSelection `json:"selections"`
+}
+
+type Wager struct {
+	GameName GameType `json:"gameName"`
+	DrawID   string   `json:"drawId"`
+	// Price is the total cost of the wager
+	Price Money      `json:"price"`
+	Rows  []WagerRow `json:"boards"`
+}
+
+// End of Request payload types
+
+// Response Types for WagerService Endpoints
+type WagerConfirmation struct {
+	ID       string   `json:"id"`
+	GameName GameType `json:"gameName"`
+	DrawID   string   `json:"drawId"`
+	Price    Money    `json:"price"`
+}
+
+// WagerCheckResult is the result of checking the wager without placing it
+type WagerCheckResult struct {
+	Status string `json:"status"`
+	// Price is the total cost of the wager, as computed by Veikkaus API
+	Price Money `json:"price"`
+}
+
+type TicketConfirmation struct {
+	SerialNumber string              `json:"serialNumber"`
+	Status       string              `json:"status"`
+	TotalCost    Money               `json:"totalCost"`
+	Wagers       []WagerConfirmation `json:"wagers"`
+}
+
+// Settlement statuses of a ticket
+const (
+	TicketStatusOpen      = "OPEN"
+	TicketStatusWon       = "WON"
+	TicketStatusLost      = "LOST"
+	TicketStatusCancelled = "CANCELLED"
+)
+
+// TicketRow is a row of a bought ticket with its hit count once the draw has results
+type TicketRow struct {
+	BetType   BetType `json:"betType"`
+	Stake     Money   `json:"stake"`
+	HitCount  int     `json:"hitCount"`
+	WinAmount Money   `json:"winAmount"`
+}
+
+// Ticket is a bought ticket with its settlement status and winnings
+type Ticket struct {
+	SerialNumber string      `json:"serialNumber"`
+	Status       string      `json:"status"`
+	GameName     GameType    `json:"gameName"`
+	DrawID       string      `json:"drawId"`
+	Time         Timestamp   `json:"time"`
+	Price        Money       `json:"price"`
+	WinAmount    Money       `json:"winAmount"`
+	Rows         []TicketRow `json:"boards"`
+}
+
+// IsSettled returns true when the draw of the ticket has been resolved and the winnings are final
+func (t *Ticket) IsSettled() bool {
+	return t.Status != TicketStatusOpen && t.Status != ""
+}
+
+// End of Response Types for WagerService Endpoints
+
+// WagerValidationError is returned when Veikkaus API rejects the wager input,
+// with the field errors mapped back to the rows of the wager
+type WagerValidationError struct {
+	*ValidationError
+
+	// RowErrors are the field errors keyed by the index of the offending row in Wager.Rows
+	RowErrors map[int][]FieldError
+	// WagerErrors are the field errors not related to any single row
+	WagerErrors []FieldError
+}
+
+func (e *WagerValidationError) Error() string {
+	rowIndexes := make([]int, 0, len(e.RowErrors))
+	for rowIndex := range e.RowErrors {
+		rowIndexes = append(rowIndexes, rowIndex)
+	}
+	sort.Ints(rowIndexes)
+
+	return fmt.Sprintf("wager validation failed for rows %v: %s", rowIndexes, e.ValidationError.Error())
+}
+
+func (e *WagerValidationError) Unwrap() error {
+	return e.ValidationError
+}
+
+// submit posts the wager to the endpoint and maps input validation errors to the rows of the wager
+func (s *WagerService) submit(ctx context.Context, endpoint string, wager *Wager, responseInterface interface{}) (*http.Response, error) {
+	if err := validateDrawGameType(wager.GameName); err != nil {
+		return nil, err
+	}
+
+	body, err := api.GetJSONPayload(wager)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.apiClient.NewRequest(ctx, http.MethodPost, endpoint, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.apiClient.doJSON(ctx, req, responseInterface, true)
+
+	if err != nil {
+		var validationErr *ValidationError
+		if errors.As(err, &validationErr) {
+			return resp, newWagerValidationError(validationErr)
+		}
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+var rowFieldPattern = regexp.MustCompile(`boards\[(\d+)\]`)
+
+// getRowIndex returns the index of the row the field path (e.g. "boards[1].selections[0].outcomes") refers to
+func getRowIndex(field string) (int, bool) {
+	match := rowFieldPattern.FindStringSubmatch(field)
+	if match == nil {
+		return 0, false
+	}
+
+	rowIndex, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return rowIndex, true
+}
+
+func newWagerValidationError(validationErr *ValidationError) *WagerValidationError {
+	wagerErr := &WagerValidationError{
+		ValidationError: validationErr,
+		RowErrors:       map[int][]FieldError{},
+	}
+
+	for _, fieldErr := range validationErr.FieldErrors {
+		if rowIndex, ok := getRowIndex(fieldErr.Field); ok {
+			wagerErr.RowErrors[rowIndex] = append(wagerErr.RowErrors[rowIndex], fieldErr)
+		} else {
+			wagerErr.WagerErrors = append(wagerErr.WagerErrors, fieldErr)
+		}
+	}
+
+	return wagerErr
+}
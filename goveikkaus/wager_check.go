@@ -0,0 +1,24 @@
+package goveikkaus
+
+import (
+	"context"
+	"net/http"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// Check validates the wager on Veikkaus API without placing it, and returns the price computed by the API.
+// Invalid wager returns *WagerValidationError, which wraps the *ValidationError returned by the API.
+func (s *WagerService) Check(ctx context.Context, wager *Wager) (*WagerCheckResult, *http.Response, error) {
+	var result WagerCheckResult
+
+	resp, err := s.submit(ctx, api.WagerCheckEndpoint, wager, &result)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer resp.Body.Close()
+
+	return &result, resp, nil
+}
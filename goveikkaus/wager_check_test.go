@@ -0,0 +1,91 @@
+package goveikkaus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var _ = Describe("wagerservice: check", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+	var placeCalls int
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+		client.SessionTimeout = time.Now().Add(time.Hour)
+		placeCalls = 0
+
+		mux.HandleFunc("/"+api.WagerEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			placeCalls++
+			writeResponse(w, http.StatusOK, ticketConfirmationBytes)
+		})
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("Check",
+		func(shouldSucceed bool, expectedStatusCode int, expectedErr error, expectedResponseBody []byte) {
+			mux.HandleFunc("/"+api.WagerCheckEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal(http.MethodPost))
+
+				body, err := io.ReadAll(r.Body)
+				Expect(err).To(BeNil())
+				var wager Wager
+				Expect(json.Unmarshal(body, &wager)).To(Succeed())
+				Expect(wager).To(Equal(*getSportWager()))
+
+				writeResponse(w, expectedStatusCode, expectedResponseBody)
+			})
+
+			ctx := context.Background()
+			result, _, err := client.Wager.Check(ctx, getSportWager())
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(result.Price).To(Equal(EuroCents(30)))
+				Expect(result.Status).To(Equal("OK"))
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(result).To(BeNil())
+			}
+			Expect(placeCalls).To(BeZero())
+		},
+		Entry("should return the computed price on happy-case", true, http.StatusOK, nil, []byte(`{"status":"OK","price":30}`)),
+		Entry("should return wager validation error when input validation fails", false, http.StatusBadRequest, &WagerValidationError{}, wagerValidationErrorBytes),
+	)
+	Describe("Check", func() {
+		It("should return validation error with per-field detail", func() {
+			mux.HandleFunc("/"+api.WagerCheckEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusBadRequest, wagerValidationErrorBytes)
+			})
+
+			_, _, err := client.Wager.Check(context.Background(), getSportWager())
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.FieldErrors).To(HaveLen(3))
+			Expect(validationErr.FieldErrors[0]).To(Equal(FieldError{Field: "boards[1].selections[0].outcomes", Code: "INVALID", Message: "invalid outcome"}))
+		})
+		It("should return error before the request when user is not logged in", func() {
+			client.SessionTimeout = time.Time{}
+
+			result, resp, err := client.Wager.Check(context.Background(), getSportWager())
+
+			Expect(err).To(BeAssignableToTypeOf(&UserNotLoggedInError{}))
+			Expect(resp).To(BeNil())
+			Expect(result).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package goveikkaus
+
+import (
+	"context"
+	"net/http"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// Place submits the wager and returns the confirmation of the ticket bought with it. With
+// Client.EnforcePlayerLimits the wager is refused with *LimitExceededError before submitting
+// when it would exceed the player's remaining limits.
+func (s *WagerService) Place(ctx context.Context, wager *Wager) (*TicketConfirmation, *http.Response, error) {
+	if s.apiClient.EnforcePlayerLimits {
+		if resp, err := s.checkPlayerLimits(ctx, wager); err != nil {
+			return nil, resp, err
+		}
+	}
+
+	var confirmation TicketConfirmation
+
+	// Never retry placing the wager, a retry after a lost response could buy the ticket twice
+	resp, err := s.submit(withoutRetry(ctx), api.WagerEndpoint, wager, &confirmation)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer resp.Body.Close()
+
+	return &confirmation, resp, nil
+}
+
+func (s *WagerService) checkPlayerLimits(ctx context.Context, wager *Wager) (*http.Response, error) {
+	limits, resp, err := s.apiClient.Account.Limits(ctx)
+
+	if err != nil {
+		return resp, err
+	}
+
+	return nil, limits.CheckWager(CalculateWagerCost(wager))
+}
@@ -0,0 +1,159 @@
+package goveikkaus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+func getSportWager() *Wager {
+	return &Wager{
+		GameName: GameTypeSport,
+		DrawID:   "51234",
+		Price:    EuroCents(30),
+		Rows: []WagerRow{
+			{
+				BetType:    BetTypeSingle,
+				Stake:      EuroCents(10),
+				Selections: []WagerSelection{{Outcomes: []string{"1"}}, {Outcomes: []string{"X"}}},
+			},
+			{
+				BetType:    BetTypeSystem,
+				Stake:      EuroCents(10),
+				Selections: []WagerSelection{{Outcomes: []string{"1", "2"}}, {Outcomes: []string{"X"}}},
+			},
+		},
+	}
+}
+
+var ticketConfirmationBytes = []byte(`{"serialNumber":"1234-5678-9012","status":"ACCEPTED","totalCost":30,"wagers":[{"id":"1","gameName":"SPORT","drawId":"51234","price":30}]}`)
+var wagerValidationErrorBytes = []byte(`{"code":"INPUT_VALIDATION_FAILED","fieldErrors":[{"field":"boards[1].selections[0].outcomes","code":"INVALID","message":"invalid outcome"},{"field":"boards[1].stake","code":"TOO_SMALL","message":"stake too small"},{"field":"price","code":"MISMATCH","message":"price does not match"}]}`)
+
+var _ = Describe("wagerservice: place", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+		client.SessionTimeout = time.Now().Add(time.Hour)
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("Place",
+		func(shouldSucceed bool, expectedStatusCode int, expectedErr error, expectedResponseBody []byte) {
+			mux.HandleFunc("/"+api.WagerEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal(http.MethodPost))
+
+				body, err := io.ReadAll(r.Body)
+				Expect(err).To(BeNil())
+				var wager Wager
+				Expect(json.Unmarshal(body, &wager)).To(Succeed())
+				Expect(wager).To(Equal(*getSportWager()))
+
+				writeResponse(w, expectedStatusCode, expectedResponseBody)
+			})
+
+			ctx := context.Background()
+			confirmation, _, err := client.Wager.Place(ctx, getSportWager())
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(confirmation.SerialNumber).To(Equal("1234-5678-9012"))
+				Expect(confirmation.TotalCost).To(Equal(EuroCents(30)))
+				Expect(confirmation.Wagers).To(HaveLen(1))
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(confirmation).To(BeNil())
+			}
+		},
+		Entry("should return ticket confirmation on happy-case", true, http.StatusOK, nil, ticketConfirmationBytes),
+		Entry("should return wager validation error when input validation fails", false, http.StatusBadRequest, &WagerValidationError{}, wagerValidationErrorBytes),
+		Entry("should return unauthorized error when session has expired on the server", false, http.StatusUnauthorized, &UnauthorizedError{}, notAuthenticatedBytes),
+	)
+	Describe("Place", func() {
+		It("should map field errors back to the offending rows", func() {
+			mux.HandleFunc("/"+api.WagerEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusBadRequest, wagerValidationErrorBytes)
+			})
+
+			_, _, err := client.Wager.Place(context.Background(), getSportWager())
+
+			var wagerErr *WagerValidationError
+			Expect(errors.As(err, &wagerErr)).To(BeTrue())
+			Expect(wagerErr.RowErrors).To(HaveLen(1))
+			Expect(wagerErr.RowErrors[1]).To(HaveLen(2))
+			Expect(wagerErr.WagerErrors).To(HaveLen(1))
+			Expect(wagerErr.WagerErrors[0].Field).To(Equal("price"))
+			Expect(err.Error()).To(ContainSubstring("rows [1]"))
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+		})
+		It("should return error before the request when user is not logged in", func() {
+			client.SessionTimeout = time.Time{}
+
+			confirmation, resp, err := client.Wager.Place(context.Background(), getSportWager())
+
+			Expect(err).To(BeAssignableToTypeOf(&UserNotLoggedInError{}))
+			Expect(resp).To(BeNil())
+			Expect(confirmation).To(BeNil())
+		})
+		It("should return error for game types without draws", func() {
+			wager := getSportWager()
+			wager.GameName = GameTypeFixedOdds
+
+			_, _, err := client.Wager.Place(context.Background(), wager)
+
+			Expect(err).To(BeAssignableToTypeOf(&UnsupportedGameTypeError{}))
+		})
+	})
+	DescribeTable("Place with player limits",
+		func(remaining int64, expectedErr error, expectedPlaceCalls int) {
+			placeCalls := 0
+			mux.HandleFunc("/"+api.WagerEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				placeCalls++
+				writeResponse(w, http.StatusOK, ticketConfirmationBytes)
+			})
+			mux.HandleFunc("/"+api.AccountLimitsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusOK, []byte(fmt.Sprintf(`{"limits":[{"type":"WAGER","period":"MONTHLY","amount":50000,"remaining":40000},{"type":"LOSS","period":"DAILY","amount":5000,"remaining":%d}]}`, remaining)))
+			})
+			client.EnforcePlayerLimits = true
+
+			confirmation, _, err := client.Wager.Place(context.Background(), getSportWager())
+
+			if expectedErr == nil {
+				Expect(err).To(BeNil())
+				Expect(confirmation).NotTo(BeNil())
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(confirmation).To(BeNil())
+			}
+			Expect(placeCalls).To(Equal(expectedPlaceCalls))
+		},
+		Entry("should place the wager within the remaining limit", int64(30), nil, 1),
+		Entry("should refuse the wager exceeding the remaining limit", int64(29), &LimitExceededError{}, 0),
+	)
+	DescribeTable("getRowIndex",
+		func(field string, expectedIndex int, expectedOk bool) {
+			rowIndex, ok := getRowIndex(field)
+			Expect(ok).To(Equal(expectedOk))
+			Expect(rowIndex).To(Equal(expectedIndex))
+		},
+		Entry("should parse row index from nested field", "boards[3].selections[0].outcomes", 3, true),
+		Entry("should parse row index from row field", "boards[12]", 12, true),
+		Entry("should return false for wager level field", "drawId", 0, false),
+	)
+})
@@ -0,0 +1,32 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// Ticket returns the player's ticket by its serial number. Requires an authenticated session.
+func (s *WagerService) Ticket(ctx context.Context, serialNumber string) (*Ticket, *http.Response, error) {
+	endpoint := fmt.Sprintf(api.TicketEndpoint, url.PathEscape(serialNumber))
+	req, err := s.apiClient.NewRequest(ctx, http.MethodGet, endpoint, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ticket Ticket
+
+	resp, err := s.apiClient.doJSON(ctx, req, &ticket, true)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	defer resp.Body.Close()
+
+	return &ticket, resp, nil
+}
@@ -0,0 +1,80 @@
+package goveikkaus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var ticketResponseBytes = []byte(`{"serialNumber":"1234-5678","status":"WON","gameName":"SPORT","drawId":"51234","time":1707051600000,"price":30,"winAmount":215031,"boards":[{"betType":"REGULAR","stake":10,"hitCount":12,"winAmount":215031},{"betType":"SYSTEM","stake":10,"hitCount":9,"winAmount":0}]}`)
+
+var _ = Describe("wagerservice: ticket", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	var notFoundErrorBytes = []byte(`{"code": "NOT_FOUND", "fieldErrors": []}`)
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+		client.SessionTimeout = time.Now().Add(time.Hour)
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	DescribeTable("Ticket",
+		func(shouldSucceed bool, expectedStatusCode int, expectedErr error, expectedResponseBody []byte) {
+			mux.HandleFunc("/"+fmt.Sprintf(api.TicketEndpoint, "1234-5678"), func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal(http.MethodGet))
+				writeResponse(w, expectedStatusCode, expectedResponseBody)
+			})
+
+			ctx := context.Background()
+			ticket, _, err := client.Wager.Ticket(ctx, "1234-5678")
+
+			if shouldSucceed {
+				Expect(err).To(BeNil())
+				Expect(ticket.SerialNumber).To(Equal("1234-5678"))
+				Expect(ticket.IsSettled()).To(BeTrue())
+				Expect(ticket.WinAmount.Cents).To(Equal(int64(215031)))
+				Expect(ticket.Rows).To(HaveLen(2))
+				Expect(ticket.Rows[0].HitCount).To(Equal(12))
+				Expect(ticket.Rows[1].BetType).To(Equal(BetTypeSystem))
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(expectedErr))
+				Expect(ticket).To(BeNil())
+			}
+		},
+		Entry("should return the ticket on happy-case", true, http.StatusOK, nil, ticketResponseBytes),
+		Entry("should return error when ticket is not found", false, http.StatusNotFound, &APIError{}, notFoundErrorBytes),
+		Entry("should return error when session has expired", false, http.StatusUnauthorized, &UnauthorizedError{}, notAuthenticatedBytes),
+	)
+	Describe("Ticket", func() {
+		It("should return error before the request without an authenticated session", func() {
+			requests := 0
+			mux.HandleFunc("/"+fmt.Sprintf(api.TicketEndpoint, "1234-5678"), func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				writeResponse(w, http.StatusOK, ticketResponseBytes)
+			})
+			client.SessionTimeout = time.Time{}
+
+			ticket, _, err := client.Wager.Ticket(context.Background(), "1234-5678")
+
+			Expect(err).To(BeAssignableToTypeOf(&UserNotLoggedInError{}))
+			Expect(ticket).To(BeNil())
+			Expect(requests).To(BeZero())
+		})
+		It("should not be settled while the draw is open", func() {
+			Expect((&Ticket{Status: TicketStatusOpen}).IsSettled()).To(BeFalse())
+			Expect((&Ticket{Status: TicketStatusLost}).IsSettled()).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,11 @@
+package goveikkaus
+
+import (
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+// Tickets returns a pager over the player's recent tickets, newest first. Use HistoryOptions.Status
+// to list e.g. only TicketStatusWon tickets. Requires an authenticated session, opts may be nil.
+func (s *WagerService) Tickets(opts *HistoryOptions) *Pager[Ticket] {
+	return newPager[Ticket](s.apiClient, api.WagerEndpoint, getHistoryQuery(opts), getPageSize(opts))
+}
@@ -0,0 +1,51 @@
+package goveikkaus
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/j-flat/go-veikkaus/internal/veikkausapi"
+)
+
+var _ = Describe("wagerservice: tickets", func() {
+	var client *Client
+	var mux *http.ServeMux
+	var teardown func()
+
+	BeforeEach(func() {
+		client, mux, _, teardown = setup()
+		client.SessionTimeout = time.Now().Add(time.Hour)
+	})
+
+	AfterEach(func() {
+		defer teardown()
+	})
+
+	It("should list the recent tickets with the status filter", func() {
+		mux.HandleFunc("/"+api.WagerEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodGet))
+			Expect(r.URL.Query().Get("status")).To(Equal(TicketStatusWon))
+			writeResponse(w, http.StatusOK, []byte(`{"total":1,"items":[`+string(ticketResponseBytes)+`]}`))
+		})
+
+		pager := client.Wager.Tickets(&HistoryOptions{Status: TicketStatusWon})
+
+		Expect(pager.Next(context.Background())).To(BeTrue())
+		Expect(pager.Value().SerialNumber).To(Equal("1234-5678"))
+		Expect(pager.Value().Rows[0].WinAmount).To(Equal(Money{Cents: 215031, Currency: DefaultCurrency}))
+		Expect(pager.Next(context.Background())).To(BeFalse())
+		Expect(pager.Err()).To(BeNil())
+	})
+	It("should return error without an authenticated session", func() {
+		client.SessionTimeout = time.Time{}
+
+		pager := client.Wager.Tickets(nil)
+
+		Expect(pager.Next(context.Background())).To(BeFalse())
+		Expect(pager.Err()).To(BeAssignableToTypeOf(&UserNotLoggedInError{}))
+	})
+})
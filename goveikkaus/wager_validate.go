@@ -0,0 +1,294 @@
+package goveikkaus
+
+import (
+	"fmt"
+	"time"
+)
+
+type stakeLimits struct {
+	min int
+	max int
+}
+
+// defaultStakeLimits are the stake limits in cents described in GameGlossary, used when the draw
+// does not define its own limits in GameRuleSet
+var defaultStakeLimits = map[GameType]stakeLimits{
+	GameTypeSport:      {min: 10, max: 25},
+	GameTypeMultiScore: {min: 5, max: 10000},
+	GameTypeScore:      {min: 100, max: 10000},
+	GameTypeWinner:     {min: 20, max: 10000},
+}
+
+var sportOutcomes = map[string]bool{"1": true, "X": true, "2": true}
+
+// Number of positions a row has in the games betting on the finishing order
+var finishingOrderPositions = map[GameType]int{
+	GameTypeWinner:   1,
+	GameTypePerfecta: 2,
+	GameTypeTrifecta: 3,
+}
+
+func isScoreGame(gameType GameType) bool {
+	return gameType == GameTypeMultiScore || gameType == GameTypeScore
+}
+
+func isCompetitorGame(gameType GameType) bool {
+	switch gameType {
+	case GameTypeWinner, GameTypePickTwo, GameTypePickThree, GameTypePerfecta, GameTypeTrifecta:
+		return true
+	default:
+		return false
+	}
+}
+
+// getSelectionCount returns the number of choices made in the selection
+func getSelectionCount(gameType GameType, selection WagerSelection) int {
+	switch {
+	case isScoreGame(gameType):
+		return len(selection.HomeScores) * len(selection.AwayScores)
+	case isCompetitorGame(gameType):
+		return len(selection.Competitors)
+	default:
+		return len(selection.Outcomes)
+	}
+}
+
+// countDistinctOrders returns the number of ways to pick a different competitor for each position
+func countDistinctOrders(selections []WagerSelection, used map[int]bool) int {
+	if len(selections) == 0 {
+		return 1
+	}
+
+	count := 0
+	for _, competitor := range selections[0].Competitors {
+		if used[competitor] {
+			continue
+		}
+		used[competitor] = true
+		count += countDistinctOrders(selections[1:], used)
+		delete(used, competitor)
+	}
+
+	return count
+}
+
+// CalculateRowCombinations returns the number of single row combinations the row is played as
+func CalculateRowCombinations(gameType GameType, row WagerRow) int {
+	if len(row.Selections) == 0 {
+		return 0
+	}
+
+	if _, ok := finishingOrderPositions[gameType]; ok {
+		return countDistinctOrders(row.Selections, map[int]bool{})
+	}
+
+	combinations := 1
+	for _, selection := range row.Selections {
+		combinations *= getSelectionCount(gameType, selection)
+	}
+
+	return combinations
+}
+
+// CalculateWagerCost returns the total cost of the wager
+func CalculateWagerCost(wager *Wager) Money {
+	cost := EuroCents(0)
+	for _, row := range wager.Rows {
+		cost = cost.Add(row.Stake.Mul(int64(CalculateRowCombinations(wager.GameName, row))))
+	}
+
+	return cost
+}
+
+type wagerValidator struct {
+	draw        *Draw
+	wager       *Wager
+	fieldErrors []FieldError
+}
+
+func (v *wagerValidator) addError(field, code, message string) {
+	v.fieldErrors = append(v.fieldErrors, FieldError{Field: field, Code: code, Message: message})
+}
+
+func (v *wagerValidator) getStakeLimits() stakeLimits {
+	limits := defaultStakeLimits[v.wager.GameName]
+
+	if ruleSet := v.draw.GameRuleSet; ruleSet.MinStake > 0 || ruleSet.MaxStake > 0 {
+		limits = stakeLimits{min: ruleSet.MinStake, max: ruleSet.MaxStake}
+	}
+
+	return limits
+}
+
+func (v *wagerValidator) getExpectedSelectionCount() int {
+	if positions, ok := finishingOrderPositions[v.wager.GameName]; ok {
+		return positions
+	}
+
+	return len(v.draw.Rows)
+}
+
+// getDrawRow returns the draw row the selection is made for
+func (v *wagerValidator) getDrawRow(selectionIndex int) *DrawRow {
+	if _, ok := finishingOrderPositions[v.wager.GameName]; ok {
+		selectionIndex = 0
+	}
+
+	if selectionIndex >= len(v.draw.Rows) {
+		return nil
+	}
+
+	return &v.draw.Rows[selectionIndex]
+}
+
+func (v *wagerValidator) validateDraw() {
+	if v.wager.GameName != v.draw.GameName {
+		v.addError("gameName", "MISMATCH", fmt.Sprintf("wager is for game '%s' but draw is for game '%s'", v.wager.GameName, v.draw.GameName))
+	}
+
+	if v.wager.DrawID != v.draw.ID {
+		v.addError("drawId", "MISMATCH", fmt.Sprintf("wager is for draw '%s' but draw is '%s'", v.wager.DrawID, v.draw.ID))
+	}
+
+	if v.draw.Status != "" && v.draw.Status != "OPEN" {
+		v.addError("drawId", "DRAW_CLOSED", fmt.Sprintf("draw status is '%s'", v.draw.Status))
+	} else if !v.draw.CloseTime.IsZero() && !time.Now().Before(v.draw.CloseTime.Time) {
+		v.addError("drawId", "DRAW_CLOSED", fmt.Sprintf("draw closed at %s", v.draw.CloseTime.Format(time.RFC3339)))
+	}
+}
+
+func (v *wagerValidator) validateStake(field string, stake Money) {
+	limits := v.getStakeLimits()
+
+	if stake.Cents <= 0 || (limits.min > 0 && stake.Cents < int64(limits.min)) {
+		v.addError(field, "TOO_SMALL", fmt.Sprintf("stake must be at least %s", EuroCents(int64(limits.min))))
+	}
+
+	if limits.max > 0 && stake.Cents > int64(limits.max) {
+		v.addError(field, "TOO_LARGE", fmt.Sprintf("stake must be at most %s", EuroCents(int64(limits.max))))
+	}
+
+	if interval := v.draw.GameRuleSet.StakeInterval; interval > 0 && stake.Cents%int64(interval) != 0 {
+		v.addError(field, "INVALID", fmt.Sprintf("stake must be a multiple of %s", EuroCents(int64(interval))))
+	}
+}
+
+func (v *wagerValidator) validateOutcomes(field string, outcomes []string) {
+	seen := map[string]bool{}
+	for _, outcome := range outcomes {
+		if !sportOutcomes[outcome] || seen[outcome] {
+			v.addError(field, "INVALID", fmt.Sprintf("outcome '%s' is not allowed, use each of '1', 'X' and '2' at most once", outcome))
+		}
+		seen[outcome] = true
+	}
+}
+
+func (v *wagerValidator) validateScores(field string, scores []int) {
+	seen := map[int]bool{}
+	for _, score := range scores {
+		if score < 0 || seen[score] {
+			v.addError(field, "INVALID", fmt.Sprintf("score %d is not allowed, scores must be unique and non-negative", score))
+		}
+		seen[score] = true
+	}
+}
+
+func (v *wagerValidator) validateCompetitors(field string, competitors []int, drawRow *DrawRow) {
+	numbers := map[int]bool{}
+	if drawRow != nil {
+		for _, competitor := range drawRow.Competitors {
+			numbers[competitor.Number] = true
+		}
+	}
+
+	seen := map[int]bool{}
+	for _, competitor := range competitors {
+		if !numbers[competitor] || seen[competitor] {
+			v.addError(field, "INVALID", fmt.Sprintf("competitor %d is not a competitor of the draw row", competitor))
+		}
+		seen[competitor] = true
+	}
+}
+
+func (v *wagerValidator) validateSelection(field string, selection WagerSelection, drawRow *DrawRow) {
+	gameType := v.wager.GameName
+
+	switch {
+	case isScoreGame(gameType):
+		v.validateScores(field+".homeScores", selection.HomeScores)
+		v.validateScores(field+".awayScores", selection.AwayScores)
+	case isCompetitorGame(gameType):
+		v.validateCompetitors(field+".competitors", selection.Competitors, drawRow)
+	default:
+		v.validateOutcomes(field+".outcomes", selection.Outcomes)
+	}
+
+	if getSelectionCount(gameType, selection) == 0 {
+		v.addError(field, "EMPTY", "selection may not be empty")
+	}
+}
+
+func (v *wagerValidator) validateRow(rowIndex int, row WagerRow) {
+	field := fmt.Sprintf("boards[%d]", rowIndex)
+
+	v.validateStake(field+".stake", row.Stake)
+
+	if expected := v.getExpectedSelectionCount(); len(row.Selections) != expected {
+		v.addError(field+".selections", "INVALID", fmt.Sprintf("row must have %d selections, got %d", expected, len(row.Selections)))
+	}
+
+	for selectionIndex, selection := range row.Selections {
+		selectionField := fmt.Sprintf("%s.selections[%d]", field, selectionIndex)
+		v.validateSelection(selectionField, selection, v.getDrawRow(selectionIndex))
+
+		if row.BetType == BetTypeSingle && getSelectionCount(v.wager.GameName, selection) > 1 {
+			v.addError(selectionField, "INVALID", "single row may have only one choice per selection, use system row instead")
+		}
+	}
+
+	switch row.BetType {
+	case BetTypeSingle, BetTypeSystem:
+	default:
+		v.addError(field+".betType", "INVALID", fmt.Sprintf("bet type '%s' is not supported", row.BetType))
+	}
+}
+
+func (v *wagerValidator) validateCost() Money {
+	cost := CalculateWagerCost(v.wager)
+
+	if maxPrice := v.draw.GameRuleSet.MaxPrice; maxPrice > 0 && cost.Cents > int64(maxPrice) {
+		v.addError("price", "TOO_LARGE", fmt.Sprintf("wager costs %s, maximum is %s", cost, EuroCents(int64(maxPrice))))
+	}
+
+	if !v.wager.Price.IsZero() && v.wager.Price.Cmp(cost) != 0 {
+		v.addError("price", "MISMATCH", fmt.Sprintf("wager price is %s but its rows cost %s", v.wager.Price, cost))
+	}
+
+	return cost
+}
+
+// ValidateWager checks the wager against the rules of the draw without calling Veikkaus API,
+// and returns the total cost of the wager. Invalid wager returns *WagerValidationError
+// with the field errors named the same way as by Veikkaus API.
+func ValidateWager(draw *Draw, wager *Wager) (Money, error) {
+	v := &wagerValidator{draw: draw, wager: wager}
+
+	v.validateDraw()
+
+	if len(wager.Rows) == 0 {
+		v.addError("boards", "EMPTY", "wager must have at least one row")
+	}
+
+	for rowIndex, row := range wager.Rows {
+		v.validateRow(rowIndex, row)
+	}
+
+	cost := v.validateCost()
+
+	if len(v.fieldErrors) > 0 {
+		validationErr := &ValidationError{FieldErrors: v.fieldErrors}
+		return cost, newWagerValidationError(validationErr)
+	}
+
+	return cost, nil
+}
@@ -0,0 +1,204 @@
+package goveikkaus
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func getSportDraw() *Draw {
+	return &Draw{
+		ID:        "51234",
+		GameName:  GameTypeSport,
+		Status:    "OPEN",
+		CloseTime: Timestamp{time.Now().Add(time.Hour)},
+		GameRuleSet: GameRuleSet{
+			MinStake:      10,
+			MaxStake:      25,
+			StakeInterval: 5,
+			MaxPrice:      100000,
+		},
+		Rows: []DrawRow{{ID: "1"}, {ID: "2"}},
+	}
+}
+
+func getRowErrorCodes(err error) map[int][]string {
+	var wagerErr *WagerValidationError
+	Expect(errors.As(err, &wagerErr)).To(BeTrue())
+
+	codes := map[int][]string{}
+	for rowIndex, fieldErrors := range wagerErr.RowErrors {
+		for _, fieldErr := range fieldErrors {
+			codes[rowIndex] = append(codes[rowIndex], fieldErr.Code)
+		}
+	}
+	for _, fieldErr := range wagerErr.WagerErrors {
+		codes[-1] = append(codes[-1], fieldErr.Code)
+	}
+	return codes
+}
+
+var _ = Describe("wager validation", func() {
+	Describe("ValidateWager", func() {
+		It("should return the cost of a valid wager", func() {
+			cost, err := ValidateWager(getSportDraw(), getSportWager())
+
+			Expect(err).To(BeNil())
+			Expect(cost).To(Equal(EuroCents(30)))
+		})
+		It("should accept a wager without price", func() {
+			wager := getSportWager()
+			wager.Price = Money{}
+
+			cost, err := ValidateWager(getSportDraw(), wager)
+
+			Expect(err).To(BeNil())
+			Expect(cost).To(Equal(EuroCents(30)))
+		})
+		It("should reject a wager for a closed draw", func() {
+			draw := getSportDraw()
+			draw.CloseTime = Timestamp{time.Now().Add(-time.Minute)}
+
+			_, err := ValidateWager(draw, getSportWager())
+
+			Expect(getRowErrorCodes(err)).To(Equal(map[int][]string{-1: {"DRAW_CLOSED"}}))
+		})
+		It("should reject a wager for a draw that is not open", func() {
+			draw := getSportDraw()
+			draw.Status = "CLOSED"
+
+			_, err := ValidateWager(draw, getSportWager())
+
+			Expect(getRowErrorCodes(err)).To(Equal(map[int][]string{-1: {"DRAW_CLOSED"}}))
+		})
+		It("should reject a wager for another draw or game", func() {
+			wager := getSportWager()
+			wager.DrawID = "1"
+			wager.GameName = GameTypeMultiScore
+
+			_, err := ValidateWager(getSportDraw(), wager)
+
+			Expect(getRowErrorCodes(err)[-1]).To(ContainElements("MISMATCH", "MISMATCH"))
+		})
+		It("should reject a wager without rows", func() {
+			wager := getSportWager()
+			wager.Rows = nil
+			wager.Price = Money{}
+
+			_, err := ValidateWager(getSportDraw(), wager)
+
+			Expect(getRowErrorCodes(err)).To(Equal(map[int][]string{-1: {"EMPTY"}}))
+		})
+		It("should report errors of the offending rows", func() {
+			wager := getSportWager()
+			wager.Price = Money{}
+			wager.Rows[0].Selections = wager.Rows[0].Selections[:1]
+			wager.Rows[1].Selections[1].Outcomes = []string{"3"}
+			wager.Rows = append(wager.Rows, WagerRow{
+				BetType:    BetTypeSingle,
+				Stake:      EuroCents(10),
+				Selections: []WagerSelection{{Outcomes: []string{"1", "X"}}, {}},
+			})
+
+			_, err := ValidateWager(getSportDraw(), wager)
+
+			Expect(getRowErrorCodes(err)).To(Equal(map[int][]string{
+				0: {"INVALID"},
+				1: {"INVALID"},
+				2: {"INVALID", "EMPTY"},
+			}))
+		})
+		DescribeTable("stake limits",
+			func(stake int, ruleSet GameRuleSet, expectedCodes []string) {
+				draw := getSportDraw()
+				draw.GameRuleSet = ruleSet
+				wager := getSportWager()
+				wager.Price = Money{}
+				wager.Rows = wager.Rows[:1]
+				wager.Rows[0].Stake = EuroCents(int64(stake))
+
+				_, err := ValidateWager(draw, wager)
+
+				if expectedCodes == nil {
+					Expect(err).To(BeNil())
+				} else {
+					Expect(getRowErrorCodes(err)[0]).To(Equal(expectedCodes))
+				}
+			},
+			Entry("should accept stake within draw limits", 15, GameRuleSet{MinStake: 10, MaxStake: 25, StakeInterval: 5}, nil),
+			Entry("should reject stake below draw minimum", 5, GameRuleSet{MinStake: 10, MaxStake: 25}, []string{"TOO_SMALL"}),
+			Entry("should reject stake above draw maximum", 30, GameRuleSet{MinStake: 10, MaxStake: 25}, []string{"TOO_LARGE"}),
+			Entry("should reject stake not matching the interval", 12, GameRuleSet{MinStake: 10, MaxStake: 25, StakeInterval: 5}, []string{"INVALID"}),
+			Entry("should fall back to glossary limits when the draw has none", 30, GameRuleSet{}, []string{"TOO_LARGE"}),
+			Entry("should reject non-positive stake", 0, GameRuleSet{}, []string{"TOO_SMALL"}),
+		)
+		It("should reject a wager costing more than the draw allows", func() {
+			draw := getSportDraw()
+			draw.GameRuleSet.MaxPrice = 20
+
+			_, err := ValidateWager(draw, getSportWager())
+
+			Expect(getRowErrorCodes(err)).To(Equal(map[int][]string{-1: {"TOO_LARGE"}}))
+		})
+		It("should reject a wager whose price does not match its rows", func() {
+			wager := getSportWager()
+			wager.Price = EuroCents(40)
+
+			cost, err := ValidateWager(getSportDraw(), wager)
+
+			Expect(cost).To(Equal(EuroCents(30)))
+			Expect(getRowErrorCodes(err)).To(Equal(map[int][]string{-1: {"MISMATCH"}}))
+		})
+		It("should validate score and competitor selections", func() {
+			draw := &Draw{
+				ID:       "1",
+				GameName: GameTypePerfecta,
+				Rows:     []DrawRow{{Competitors: []Competitor{{Number: 1}, {Number: 2}, {Number: 3}}}},
+			}
+			wager := &Wager{
+				GameName: GameTypePerfecta,
+				DrawID:   "1",
+				Rows: []WagerRow{{
+					BetType:    BetTypeSystem,
+					Stake:      EuroCents(20),
+					Selections: []WagerSelection{{Competitors: []int{1, 4}}, {Competitors: []int{2}}},
+				}},
+			}
+
+			_, err := ValidateWager(draw, wager)
+			Expect(getRowErrorCodes(err)).To(Equal(map[int][]string{0: {"INVALID"}}))
+
+			draw = &Draw{ID: "2", GameName: GameTypeScore, Rows: []DrawRow{{}}}
+			wager = &Wager{
+				GameName: GameTypeScore,
+				DrawID:   "2",
+				Rows: []WagerRow{{
+					BetType:    BetTypeSystem,
+					Stake:      EuroCents(100),
+					Selections: []WagerSelection{{HomeScores: []int{0, 0}, AwayScores: []int{-1}}},
+				}},
+			}
+
+			_, err = ValidateWager(draw, wager)
+			Expect(getRowErrorCodes(err)).To(Equal(map[int][]string{0: {"INVALID", "INVALID"}}))
+		})
+	})
+	DescribeTable("CalculateRowCombinations",
+		func(gameType GameType, row WagerRow, expectedCombinations int) {
+			Expect(CalculateRowCombinations(gameType, row)).To(Equal(expectedCombinations))
+		},
+		Entry("should count a single sport row as one", GameTypeSport, WagerRow{Selections: []WagerSelection{{Outcomes: []string{"1"}}, {Outcomes: []string{"2"}}}}, 1),
+		Entry("should multiply sport system selections", GameTypeSport, WagerRow{Selections: []WagerSelection{{Outcomes: []string{"1", "X", "2"}}, {Outcomes: []string{"1", "2"}}, {Outcomes: []string{"X"}}}}, 6),
+		Entry("should multiply home and away scores", GameTypeMultiScore, WagerRow{Selections: []WagerSelection{{HomeScores: []int{0, 1}, AwayScores: []int{0, 1, 2}}, {HomeScores: []int{1}, AwayScores: []int{1, 2}}}}, 12),
+		Entry("should multiply pick two legs", GameTypePickTwo, WagerRow{Selections: []WagerSelection{{Competitors: []int{1, 2}}, {Competitors: []int{1, 2, 3}}}}, 6),
+		Entry("should count only distinct finishing orders", GameTypeTrifecta, WagerRow{Selections: []WagerSelection{{Competitors: []int{1, 2}}, {Competitors: []int{1, 2}}, {Competitors: []int{1, 2, 3}}}}, 2),
+		Entry("should return zero for row without selections", GameTypeSport, WagerRow{}, 0),
+	)
+	Describe("CalculateWagerCost", func() {
+		It("should sum the cost of each row", func() {
+			Expect(CalculateWagerCost(getSportWager())).To(Equal(EuroCents(30)))
+		})
+	})
+})
@@ -8,11 +8,37 @@ const (
 	ContentType                string = "application/json"
 	Accept                     string = "application/json"
 
+	// BaseURL is the default base URL for the clients, it should end with a trailing slash
+	BaseURL string = "https://www.veikkaus.fi/api/"
+
 	// Endpoint paths, there is some variance in the paths on Veikkaus API
 	LoginEndpoint          string = "bff/v1/sessions"
 	AccountBalanceEndpoint string = "v1/players/self/account"
+
+	// Account history endpoints, paged with offset and limit query parameters
+	AccountTransactionsEndpoint string = "v1/players/self/account/transactions"
+	AccountWagersEndpoint       string = "v1/players/self/wagers"
+
+	// AccountLimitsEndpoint returns the player's responsible gaming limits and accepts limit change requests
+	AccountLimitsEndpoint string = "v1/players/self/limits"
+
+	// Sport game draw endpoints, formatted with game type and draw ID
+	DrawsEndpoint string = "sport-open-games/v1/games/%s/draws"
+	DrawEndpoint  string = "sport-open-games/v1/games/%s/draws/%s"
+
+	// Odds and popularity endpoints of open draws, formatted with game type and draw ID
+	DrawOddsEndpoint       string = "sport-odds/v1/games/%s/draws/%s/odds"
+	DrawPopularityEndpoint string = "sport-popularity/v1/games/%s/draws/%s/popularity"
+
+	// Result endpoints of closed draws, formatted with game type and draw ID
+	DrawResultEndpoint    string = "sport-open-games/v1/games/%s/draws/%s/result"
+	DrawWinSharesEndpoint string = "sport-open-games/v1/games/%s/draws/%s/winshares"
+
+	WagerEndpoint      string = "sport-interactive-wager/v1/tickets"
+	WagerCheckEndpoint string = "sport-interactive-wager/v1/tickets/check"
+	// TicketEndpoint is formatted with the serial number of the ticket
+	TicketEndpoint string = "sport-interactive-wager/v1/tickets/%s"
 )
 
 // SessionTimeoutSeconds is half-hour as shown here: https://github.com/VeikkausOy/sport-games-robot/issues/160
 var SessionTimeoutSeconds int = 1800
-var BaseURL string = "https://www.veikkaus.fi/api/"
@@ -0,0 +1,64 @@
+package veikkausapi
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"sync"
+)
+
+var AuthSessionCookie = "JSESSIONID"
+
+// CookieJar is a http.CookieJar safe for concurrent use, scoping the cookies by domain and path
+// as implemented by net/http/cookiejar. The zero value is an empty jar ready to use.
+type CookieJar struct {
+	mu  sync.Mutex
+	jar *cookiejar.Jar
+}
+
+// getJar returns the current jar, creating it on first use
+func (jar *CookieJar) getJar() *cookiejar.Jar {
+	jar.mu.Lock()
+	defer jar.mu.Unlock()
+
+	if jar.jar == nil {
+		// cookiejar.New returns an error only for invalid options
+		jar.jar, _ = cookiejar.New(nil)
+	}
+
+	return jar.jar
+}
+
+func (jar *CookieJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	if u == nil {
+		return
+	}
+
+	jar.getJar().SetCookies(u, cookies)
+}
+
+func (jar *CookieJar) Cookies(u *url.URL) []*http.Cookie {
+	if u == nil {
+		return nil
+	}
+
+	return jar.getJar().Cookies(u)
+}
+
+// Clear removes all cookies from the jar
+func (jar *CookieJar) Clear() {
+	jar.mu.Lock()
+	defer jar.mu.Unlock()
+
+	jar.jar = nil
+}
+
+func (jar *CookieJar) IsAuthenticated(u *url.URL) bool {
+	for _, cookie := range jar.Cookies(u) {
+		if cookie.Name == AuthSessionCookie {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,153 @@
+package veikkausapi
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var dummyURL, _ = url.Parse("http://localhost:8080")
+var veikkausURL, _ = url.Parse("https://www.veikkaus.fi/api/bff/v1/sessions")
+
+var dummyCookie1 = &http.Cookie{
+	Name:  "cookie1",
+	Value: "value1",
+}
+
+var dummyCookie2 = &http.Cookie{
+	Name:  "cookie2",
+	Value: "value2",
+}
+
+var dummyCookies = []*http.Cookie{dummyCookie1, dummyCookie2}
+
+func mustParseURL(rawURL string) *url.URL {
+	u, err := url.Parse(rawURL)
+	Expect(err).To(BeNil())
+	return u
+}
+
+func getCookieNames(cookies []*http.Cookie) []string {
+	names := []string{}
+	for _, cookie := range cookies {
+		names = append(names, cookie.Name+"="+cookie.Value)
+	}
+	return names
+}
+
+var _ = Describe("internal/veikkausapi: cookie jar", func() {
+	var jar *CookieJar
+
+	BeforeEach(func() {
+		jar = &CookieJar{}
+	})
+
+	Describe("SetCookies", func() {
+		It("should store cookies for the url", func() {
+			jar.SetCookies(dummyURL, dummyCookies)
+			Expect(getCookieNames(jar.Cookies(dummyURL))).To(ConsistOf("cookie1=value1", "cookie2=value2"))
+		})
+		It("should replace a cookie with the same name instead of appending it", func() {
+			jar.SetCookies(veikkausURL, []*http.Cookie{{Name: AuthSessionCookie, Value: "old", Path: "/"}})
+			jar.SetCookies(veikkausURL, []*http.Cookie{{Name: AuthSessionCookie, Value: "new", Path: "/"}})
+
+			Expect(getCookieNames(jar.Cookies(veikkausURL))).To(Equal([]string{AuthSessionCookie + "=new"}))
+		})
+		It("should remove the cookie when it is set with negative Max-Age", func() {
+			jar.SetCookies(dummyURL, dummyCookies)
+			jar.SetCookies(dummyURL, []*http.Cookie{{Name: "cookie1", MaxAge: -1}})
+
+			Expect(getCookieNames(jar.Cookies(dummyURL))).To(Equal([]string{"cookie2=value2"}))
+		})
+		It("should ignore cookies for domains the host cannot set", func() {
+			jar.SetCookies(veikkausURL, []*http.Cookie{{Name: "evil", Value: "1", Domain: "example.com"}})
+
+			Expect(jar.Cookies(mustParseURL("https://example.com/"))).To(BeEmpty())
+			Expect(jar.Cookies(veikkausURL)).To(BeEmpty())
+		})
+		It("should ignore nil urls", func() {
+			jar.SetCookies(nil, dummyCookies)
+			Expect(jar.Cookies(nil)).To(BeNil())
+		})
+	})
+	Describe("Cookies", func() {
+		It("should scope host-only cookies to the exact host", func() {
+			jar.SetCookies(veikkausURL, []*http.Cookie{{Name: "a", Value: "1", Path: "/"}})
+
+			Expect(jar.Cookies(mustParseURL("https://www.veikkaus.fi/"))).To(HaveLen(1))
+			Expect(jar.Cookies(mustParseURL("https://m.veikkaus.fi/"))).To(BeEmpty())
+		})
+		It("should share domain cookies with subdomains", func() {
+			jar.SetCookies(veikkausURL, []*http.Cookie{{Name: "a", Value: "1", Path: "/", Domain: ".veikkaus.fi"}})
+
+			Expect(jar.Cookies(mustParseURL("https://m.veikkaus.fi/"))).To(HaveLen(1))
+			Expect(jar.Cookies(mustParseURL("https://notveikkaus.fi/"))).To(BeEmpty())
+		})
+		It("should scope cookies by path", func() {
+			jar.SetCookies(veikkausURL, []*http.Cookie{
+				{Name: "default", Value: "1"},
+				{Name: "api", Value: "2", Path: "/api"},
+			})
+
+			Expect(getCookieNames(jar.Cookies(mustParseURL("https://www.veikkaus.fi/api/bff/v1/other")))).To(Equal([]string{"default=1", "api=2"}))
+			Expect(getCookieNames(jar.Cookies(mustParseURL("https://www.veikkaus.fi/api/v1/players")))).To(Equal([]string{"api=2"}))
+			Expect(jar.Cookies(mustParseURL("https://www.veikkaus.fi/apix"))).To(BeEmpty())
+		})
+		It("should not send secure cookies over plain http", func() {
+			jar.SetCookies(veikkausURL, []*http.Cookie{{Name: "a", Value: "1", Path: "/", Secure: true}})
+
+			Expect(jar.Cookies(mustParseURL("http://www.veikkaus.fi/"))).To(BeEmpty())
+			Expect(jar.Cookies(mustParseURL("https://www.veikkaus.fi/"))).To(HaveLen(1))
+		})
+		It("should not return expired cookies", func() {
+			jar.SetCookies(dummyURL, []*http.Cookie{
+				{Name: "expired", Value: "1", Expires: time.Now().Add(-time.Minute)},
+				{Name: "long", Value: "2", Expires: time.Now().Add(time.Hour)},
+				{Name: "session", Value: "3"},
+			})
+
+			Expect(getCookieNames(jar.Cookies(dummyURL))).To(ConsistOf("long=2", "session=3"))
+		})
+	})
+	Describe("Clear", func() {
+		It("should remove all cookies from the jar", func() {
+			jar.SetCookies(dummyURL, dummyCookies)
+			jar.Clear()
+			Expect(jar.Cookies(dummyURL)).To(BeEmpty())
+		})
+	})
+	Describe("concurrent use", func() {
+		It("should be safe for concurrent use", func() {
+			zeroJar := &CookieJar{}
+			var wg sync.WaitGroup
+
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					zeroJar.SetCookies(veikkausURL, []*http.Cookie{{Name: AuthSessionCookie, Value: "abc", Path: "/"}})
+					zeroJar.Cookies(veikkausURL)
+					zeroJar.IsAuthenticated(veikkausURL)
+				}()
+			}
+			wg.Wait()
+
+			Expect(zeroJar.Cookies(veikkausURL)).To(HaveLen(1))
+		})
+	})
+	DescribeTable("IsAuthenticated",
+		func(cookies []*http.Cookie, expected bool) {
+			jar.SetCookies(dummyURL, []*http.Cookie{{Name: "foo", Value: "bar"}})
+			jar.SetCookies(dummyURL, cookies)
+			Expect(jar.IsAuthenticated(dummyURL)).To(Equal(expected))
+		},
+		Entry("has no cookies", []*http.Cookie{}, false),
+		Entry("has authenticated cookie present", []*http.Cookie{{Name: AuthSessionCookie}}, true),
+		Entry("has authenticated cookie not present", []*http.Cookie{{Name: "other_cookie"}}, false),
+		Entry("has expired authenticated cookie", []*http.Cookie{{Name: AuthSessionCookie, MaxAge: -1}}, false),
+	)
+})
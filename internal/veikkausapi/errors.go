@@ -2,8 +2,10 @@ package veikkausapi
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 type ErrorCode string
@@ -11,9 +13,61 @@ type ErrorCode string
 const (
 	InputValidationFailed ErrorCode = "INPUT_VALIDATION_FAILED"
 	NotAuthenticated      ErrorCode = "NOT_AUTHENTICATED"
+	DrawClosed            ErrorCode = "DRAW_CLOSED"
+	InsufficientFunds     ErrorCode = "INSUFFICIENT_FUNDS"
+	RateLimited           ErrorCode = "RATE_LIMITED"
+	NotFound              ErrorCode = "NOT_FOUND"
+	Maintenance           ErrorCode = "MAINTENANCE"
 	Unknown               ErrorCode = "UNKNOWN"
 )
 
+// Sentinel errors to match API errors with errors.Is
+var (
+	ErrNotAuthenticated  = errors.New("not authenticated")
+	ErrValidation        = errors.New("input validation failed")
+	ErrDrawClosed        = errors.New("draw is closed")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrRateLimited       = errors.New("rate limited")
+	ErrNotFound          = errors.New("not found")
+	// ErrServiceUnavailable matches the maintenance breaks and the 503 responses of Veikkaus API
+	ErrServiceUnavailable = errors.New("service unavailable")
+)
+
+var errorCodeSentinels = map[ErrorCode]error{
+	NotAuthenticated:      ErrNotAuthenticated,
+	InputValidationFailed: ErrValidation,
+	DrawClosed:            ErrDrawClosed,
+	InsufficientFunds:     ErrInsufficientFunds,
+	RateLimited:           ErrRateLimited,
+	NotFound:              ErrNotFound,
+	Maintenance:           ErrServiceUnavailable,
+}
+
+var statusCodeSentinels = map[int]error{
+	http.StatusUnauthorized:       ErrNotAuthenticated,
+	http.StatusNotFound:           ErrNotFound,
+	http.StatusTooManyRequests:    ErrRateLimited,
+	http.StatusServiceUnavailable: ErrServiceUnavailable,
+}
+
+// Maximum length of the response body shown in the message of APIError without an error code
+const maxBodySnippetLength = 200
+
+// matchesSentinel reports whether the API error code, or the code of any of its field errors, is the target sentinel
+func matchesSentinel(code ErrorCode, fieldErrors []FieldError, target error) bool {
+	if errorCodeSentinels[code] == target {
+		return true
+	}
+
+	for _, fieldErr := range fieldErrors {
+		if sentinel, ok := errorCodeSentinels[ErrorCode(fieldErr.Code)]; ok && sentinel == target {
+			return true
+		}
+	}
+
+	return false
+}
+
 type FieldError struct {
 	Field   string `json:"field"`
 	Code    string `json:"code"`
@@ -24,7 +78,7 @@ func (f *FieldError) ToString() string {
 	return fmt.Sprintf("Field '%s' had issue: '%s'", f.Field, f.Message)
 }
 
-func getFieldErrors(fieldErrors []FieldError) []string {
+func GetFieldErrorMessages(fieldErrors []FieldError) []string {
 	var errors []string
 
 	for _, fieldErr := range fieldErrors {
@@ -53,33 +107,98 @@ func (err *UnsupportedStatusCodeError) Error() string {
 	return fmt.Sprintf("response status code was not in allowed range (200-299). Got %d", err.Code)
 }
 
+// APIError is an error response of Veikkaus API
+type APIError struct {
+	StatusCode  int
+	Code        ErrorCode
+	FieldErrors []FieldError
+
+	// Method and URL of the request the error was returned for
+	Method string
+	URL    string
+
+	// ContentType and Body are the content type and the raw body of the response
+	ContentType string
+	Body        []byte
+	// Header is the header of the response, e.g. for reading Retry-After
+	Header http.Header
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("%s %s: %d (%s) %q", e.Method, e.URL, e.StatusCode, e.ContentType, e.BodySnippet())
+	}
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.URL, e.StatusCode, e.Code)
+}
+
+// BodySnippet returns the beginning of the response body with the whitespace collapsed,
+// e.g. for logging HTML error pages
+func (e *APIError) BodySnippet() string {
+	snippet := strings.Join(strings.Fields(string(e.Body)), " ")
+
+	if runes := []rune(snippet); len(runes) > maxBodySnippetLength {
+		return string(runes[:maxBodySnippetLength]) + "..."
+	}
+
+	return snippet
+}
+
+// Is matches the sentinel errors by the error code, the field error codes and the HTTP status code
+func (e *APIError) Is(target error) bool {
+	return matchesSentinel(e.Code, e.FieldErrors, target) || statusCodeSentinels[e.StatusCode] == target
+}
+
 type UnauthorizedError struct {
 	Message string
+	// APIError is the API response, nil when the error was not returned by Veikkaus API
+	APIError *APIError
 }
 
 func (e *UnauthorizedError) Error() string {
-	return fmt.Sprintf(e.Message)
+	return e.Message
+}
+
+func (e *UnauthorizedError) Is(target error) bool {
+	return target == ErrNotAuthenticated
+}
+
+func (e *UnauthorizedError) Unwrap() error {
+	if e.APIError == nil {
+		return nil
+	}
+	return e.APIError
 }
 
 type ValidationError struct {
-	Errors []string
+	FieldErrors []FieldError
+	// APIError is the API response, nil when the wager was validated locally
+	APIError *APIError
 }
 
 func (e *ValidationError) Error() string {
-	if fmt.Sprint(e.Errors) == "[]" {
+	if len(e.FieldErrors) == 0 {
 		return "input validation error"
 	}
-	return fmt.Sprintf("input validation errors: %v", e.Errors)
+	return fmt.Sprintf("input validation errors: %v", GetFieldErrorMessages(e.FieldErrors))
 }
 
-type APIErrorNotImplementedError struct {
-	Code        ErrorCode
-	Message     string
-	FieldErrors []string
+func (e *ValidationError) Is(target error) bool {
+	return matchesSentinel(InputValidationFailed, e.FieldErrors, target)
 }
 
-func (e *APIErrorNotImplementedError) Error() string {
-	return fmt.Sprintf("API Returned error that has not been implemented in this library. Error code was '%s'", e.Code)
+func (e *ValidationError) Unwrap() error {
+	if e.APIError == nil {
+		return nil
+	}
+	return e.APIError
+}
+
+type UnsupportedGameTypeError struct {
+	GameType string
+}
+
+func (e *UnsupportedGameTypeError) Error() string {
+	return fmt.Sprintf("game type '%s' is not supported by this endpoint", e.GameType)
 }
 
 type RequestPayloadError struct {
@@ -90,23 +209,37 @@ func (e *RequestPayloadError) Error() string {
 	return "could not parse request-payload -interface to bytes"
 }
 
-func ParseAPIError(rawJSON []byte) error {
-	var response ErrorResponse
+// ParseAPIError returns the error for the error response with the given body. Responses without
+// a JSON error body, e.g. HTML maintenance pages and gateway errors, are returned as *APIError without code.
+func ParseAPIError(response *http.Response, body []byte) error {
+	apiErr := &APIError{
+		StatusCode:  response.StatusCode,
+		ContentType: response.Header.Get("Content-Type"),
+		Body:        body,
+		Header:      response.Header,
+	}
+	if response.Request != nil {
+		apiErr.Method = response.Request.Method
+		if response.Request.URL != nil {
+			apiErr.URL = response.Request.URL.String()
+		}
+	}
+
+	var errorResponse ErrorResponse
 
-	if err := json.Unmarshal(rawJSON, &response); err != nil {
-		return err // Failed to parse JSON
+	if err := json.Unmarshal(body, &errorResponse); err != nil {
+		return apiErr
 	}
 
-	switch response.Code {
+	apiErr.Code = errorResponse.Code
+	apiErr.FieldErrors = errorResponse.FieldErrors
+
+	switch errorResponse.Code {
 	case NotAuthenticated:
-		return &UnauthorizedError{Message: "User not authenticated or login failed"}
+		return &UnauthorizedError{Message: "User not authenticated or login failed", APIError: apiErr}
 	case InputValidationFailed:
-		return &ValidationError{Errors: getFieldErrors(response.FieldErrors)}
+		return &ValidationError{FieldErrors: errorResponse.FieldErrors, APIError: apiErr}
 	default:
-		return &APIErrorNotImplementedError{
-			Code:        response.Code,
-			FieldErrors: getFieldErrors(response.FieldErrors),
-			Message:     "Unsupported API Error",
-		}
+		return apiErr
 	}
 }
@@ -4,21 +4,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
-func returnUnmarshalError() error {
-	var i interface{}
-	err := json.Unmarshal(invalidPayloadBytes, &i)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 const ExampleUnsupportedHTTPStatusCode = 666
 
 var errUnauthorizedError error = errors.New("user is not authorized to perform such action")
@@ -52,30 +44,20 @@ func getInputValidationErrorBytes() []byte {
 	return bytes
 }
 
-func getErrorFieldsAsStringArray() []string {
-	var errors []string
-	errs := getValidationErrors()
-	for _, fieldErr := range errs.FieldErrors {
-		errors = append(errors, fieldErr.ToString())
-	}
+func getErrorResponse(statusCode int) *http.Response {
+	request, err := http.NewRequest(http.MethodPost, "https://www.veikkaus.fi/api/v1/foo", nil)
+	Expect(err).To(BeNil())
 
-	return errors
+	return &http.Response{StatusCode: statusCode, Request: request}
 }
 
 const ExpectedValidationErrorLength = 2
 
-func getSampleValidationErrors() []string {
-	errSampleValidationErrors := []string{}
-	errSampleValidationErrors = append(errSampleValidationErrors, "value should be 1, got 2")
-	errSampleValidationErrors = append(errSampleValidationErrors, "'foo' is not valid value for 'bar'")
-
-	return errSampleValidationErrors
-}
-
-func getValidationErrorMessage() string {
-	errors := getSampleValidationErrors()
-
-	return fmt.Sprintf("input validation errors: %v", errors)
+func getSampleFieldErrors() []FieldError {
+	return []FieldError{
+		{Field: "foo", Code: "INVALID", Message: "value should be 1, got 2"},
+		{Field: "bar", Code: "INVALID", Message: "'foo' is not valid value for 'bar'"},
+	}
 }
 
 var _ = Describe("internal/veikkausapi: errors", func() {
@@ -92,19 +74,90 @@ var _ = Describe("internal/veikkausapi: errors", func() {
 		},
 		Entry("should return 'UnsupportedStatusCode' error with HTTP-status code that caused the error", &UnsupportedStatusCodeError{Code: ExampleUnsupportedHTTPStatusCode}, fmt.Sprintf("response status code was not in allowed range (200-299). Got %d", ExampleUnsupportedHTTPStatusCode)),
 		Entry("should return 'UnauthorizedError' with original error message", &UnauthorizedError{Message: errUnauthorizedError.Error()}, "user is not authorized to perform such action"),
-		Entry("should return 'ValidationError' with static text when original error had empty list for attribute 'errors'", &ValidationError{Errors: nil}, "input validation error"),
-		Entry("should return 'ValidationError' with all validation errors in the error string, when attribute 'errors' is not empty list", &ValidationError{Errors: getSampleValidationErrors()}, getValidationErrorMessage()),
+		Entry("should return 'ValidationError' with static text when original error had no field errors", &ValidationError{FieldErrors: nil}, "input validation error"),
+		Entry("should return 'ValidationError' with all field errors in the error string", &ValidationError{FieldErrors: getSampleFieldErrors()}, "input validation errors: [Field 'foo' had issue: 'value should be 1, got 2' Field 'bar' had issue: ''foo' is not valid value for 'bar'']"),
 		Entry("should return 'UserNotLoggedInError' when user is not logged in", &UserNotLoggedInError{}, "No Authenticated session active, user not logged in"),
-		Entry("should return 'APIErrorNotImplementedError' when the API error is not known", &APIErrorNotImplementedError{Code: "TOO_JUICY"}, "API Returned error that has not been implemented in this library. Error code was 'TOO_JUICY'"),
+		Entry("should return 'UnsupportedGameTypeError' with the offending game type", &UnsupportedGameTypeError{GameType: "FIXEDODDS"}, "game type 'FIXEDODDS' is not supported by this endpoint"),
+		Entry("should return 'APIError' with the request and the error code", &APIError{StatusCode: 400, Code: "TOO_JUICY", Method: "GET", URL: "https://www.veikkaus.fi/api/v1/foo"}, "GET https://www.veikkaus.fi/api/v1/foo: 400 TOO_JUICY"),
+	)
+	Describe("ParseAPIError", func() {
+		It("should keep the field errors of validation error", func() {
+			err := ParseAPIError(getErrorResponse(http.StatusBadRequest), getInputValidationErrorBytes())
+
+			validationErr, ok := err.(*ValidationError)
+			Expect(ok).To(BeTrue())
+			Expect(validationErr.FieldErrors).To(Equal(getValidationErrors().FieldErrors))
+			Expect(validationErr.APIError.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+		It("should keep the status code, the request and the body of unknown API error", func() {
+			err := ParseAPIError(getErrorResponse(http.StatusConflict), unknownErrorBytes)
+
+			var apiErr *APIError
+			Expect(errors.As(err, &apiErr)).To(BeTrue())
+			Expect(apiErr.StatusCode).To(Equal(http.StatusConflict))
+			Expect(apiErr.Code).To(Equal(Unknown))
+			Expect(apiErr.Method).To(Equal(http.MethodPost))
+			Expect(apiErr.URL).To(Equal("https://www.veikkaus.fi/api/v1/foo"))
+			Expect(apiErr.Body).To(Equal(unknownErrorBytes))
+		})
+	})
+	DescribeTable("ParseAPIError for non-JSON bodies",
+		func(statusCode int, contentType string, body []byte, expectedMessage string, expectedServiceUnavailable bool) {
+			response := getErrorResponse(statusCode)
+			response.Header = http.Header{"Content-Type": []string{contentType}}
+
+			err := ParseAPIError(response, body)
+
+			var apiErr *APIError
+			Expect(errors.As(err, &apiErr)).To(BeTrue())
+			Expect(apiErr.StatusCode).To(Equal(statusCode))
+			Expect(apiErr.ContentType).To(Equal(contentType))
+			Expect(apiErr.Code).To(BeEmpty())
+			Expect(apiErr.Body).To(Equal(body))
+			Expect(err.Error()).To(Equal(expectedMessage))
+			Expect(errors.Is(err, ErrServiceUnavailable)).To(Equal(expectedServiceUnavailable))
+		},
+		Entry("should return 'APIError' for HTML maintenance page", http.StatusServiceUnavailable, "text/html", []byte("<html>\n  <body>Huoltokatko</body>\n</html>"), `POST https://www.veikkaus.fi/api/v1/foo: 503 (text/html) "<html> <body>Huoltokatko</body> </html>"`, true),
+		Entry("should return 'APIError' for empty body", http.StatusBadGateway, "", []byte{}, `POST https://www.veikkaus.fi/api/v1/foo: 502 () ""`, false),
+		Entry("should truncate long bodies in the message", http.StatusGatewayTimeout, "text/plain", []byte(strings.Repeat("a", 300)), fmt.Sprintf(`POST https://www.veikkaus.fi/api/v1/foo: 504 (text/plain) "%s..."`, strings.Repeat("a", 200)), false),
+	)
+	DescribeTable("errors.Is",
+		func(statusCode int, body []byte, expectedSentinel error) {
+			err := ParseAPIError(getErrorResponse(statusCode), body)
+
+			Expect(errors.Is(err, expectedSentinel)).To(BeTrue())
+		},
+		Entry("should match 'NOT_AUTHENTICATED' to ErrNotAuthenticated", http.StatusUnauthorized, unauthorizedErrorBytes, ErrNotAuthenticated),
+		Entry("should match 'INPUT_VALIDATION_FAILED' to ErrValidation", http.StatusBadRequest, getInputValidationErrorBytes(), ErrValidation),
+		Entry("should match 'DRAW_CLOSED' to ErrDrawClosed", http.StatusConflict, []byte(`{"code":"DRAW_CLOSED","fieldErrors":[]}`), ErrDrawClosed),
+		Entry("should match 'DRAW_CLOSED' field error to ErrDrawClosed", http.StatusBadRequest, []byte(`{"code":"INPUT_VALIDATION_FAILED","fieldErrors":[{"field":"drawId","code":"DRAW_CLOSED","message":"closed"}]}`), ErrDrawClosed),
+		Entry("should match 'INSUFFICIENT_FUNDS' to ErrInsufficientFunds", http.StatusConflict, []byte(`{"code":"INSUFFICIENT_FUNDS","fieldErrors":[]}`), ErrInsufficientFunds),
+		Entry("should match status code 429 to ErrRateLimited", http.StatusTooManyRequests, unknownErrorBytes, ErrRateLimited),
+		Entry("should match status code 404 to ErrNotFound", http.StatusNotFound, unknownErrorBytes, ErrNotFound),
+		Entry("should match 'MAINTENANCE' to ErrServiceUnavailable", http.StatusBadRequest, []byte(`{"code":"MAINTENANCE","fieldErrors":[]}`), ErrServiceUnavailable),
 	)
+	Describe("errors.Is", func() {
+		It("should match errors created without API response", func() {
+			Expect(errors.Is(&UnauthorizedError{}, ErrNotAuthenticated)).To(BeTrue())
+			Expect(errors.Is(&ValidationError{}, ErrValidation)).To(BeTrue())
+			Expect(errors.Is(&ValidationError{}, ErrNotFound)).To(BeFalse())
+		})
+		It("should not match unknown API error to any sentinel", func() {
+			err := ParseAPIError(getErrorResponse(http.StatusConflict), unknownErrorBytes)
+
+			for _, sentinel := range []error{ErrNotAuthenticated, ErrValidation, ErrDrawClosed, ErrInsufficientFunds, ErrRateLimited, ErrNotFound} {
+				Expect(errors.Is(err, sentinel)).To(BeFalse())
+			}
+		})
+	})
 	DescribeTable("ParseAPIError",
 		func(inputBytes []byte, expectedError error) {
-			err := ParseAPIError(inputBytes)
+			err := ParseAPIError(getErrorResponse(http.StatusBadRequest), inputBytes)
 			Expect(err).To(BeAssignableToTypeOf(expectedError))
 		},
 		Entry("should parse 'NOT_AUTHENTICATED' code to 'UnauthorizedError'", unauthorizedErrorBytes, &UnauthorizedError{Message: "User not authenticated or login failed"}),
-		Entry("should parse complex validation error to 'ValidationError'", getInputValidationErrorBytes(), &ValidationError{Errors: getErrorFieldsAsStringArray()}),
-		Entry("should return error for unprocessable bytes", invalidPayloadBytes, returnUnmarshalError()),
-		Entry("should return generidc error for unknown API error", unknownErrorBytes, &APIErrorNotImplementedError{Code: "UNKNOWN"}),
+		Entry("should parse complex validation error to 'ValidationError'", getInputValidationErrorBytes(), &ValidationError{FieldErrors: getValidationErrors().FieldErrors}),
+		Entry("should return 'APIError' for empty body", invalidPayloadBytes, &APIError{}),
+		Entry("should return generic error for unknown API error", unknownErrorBytes, &APIError{Code: "UNKNOWN"}),
 	)
 })
@@ -10,41 +10,9 @@ import (
 	"net/url"
 )
 
-var AuthSessionCookie = "JSESSIONID"
-
-type RequestCookies struct {
-	CookieSlice []*http.Cookie
-}
-
-func (rc *RequestCookies) SetCookies(u *url.URL, cookies []*http.Cookie) {
-	rc.CookieSlice = append(rc.CookieSlice, cookies...)
-}
-
-func (rc *RequestCookies) SetCookie(u *url.URL, cookie *http.Cookie) {
-	rc.CookieSlice = append(rc.CookieSlice, cookie)
-}
-
-func (rc *RequestCookies) Cookies(u *url.URL) []*http.Cookie {
-	return rc.CookieSlice
-}
-
-func (rc *RequestCookies) IsAuthenticated(url *url.URL) bool {
-	for _, cookie := range rc.Cookies(url) {
-		if cookie.Name == AuthSessionCookie {
-			return true
-		}
-	}
-
-	return false
-}
-
 // Unit-Test purposes
 var newRequest = http.NewRequest
 
-func getRequestURL(path string) string {
-	return BaseURL + path
-}
-
 func setRequestHeaders(req *http.Request) *http.Request {
 	req.Header.Set("Content-Type", ContentType)
 	req.Header.Set("Accept", Accept)
@@ -96,19 +64,27 @@ func handlePutPost(requestURL, method string, jsonPayload []byte) (*http.Request
 	return req, nil
 }
 
-func handleGet(requestURL string) (*http.Request, error) {
+func handleWithoutPayload(requestURL, method string) (*http.Request, error) {
 	if err := validateRequestURL(requestURL); err != nil {
 		return nil, err
 	}
 
-	req, err := newRequest("GET", requestURL, nil)
+	req, err := newRequest(method, requestURL, nil)
 	if err != nil {
-		errorString := fmt.Sprintf("Error creating 'GET'-request for %s: %s", requestURL, err)
+		errorString := fmt.Sprintf("Error creating '%s'-request for %s: %s", method, requestURL, err)
 		return nil, errors.New(errorString)
 	}
 	return req, nil
 }
 
+func handleGet(requestURL string) (*http.Request, error) {
+	return handleWithoutPayload(requestURL, http.MethodGet)
+}
+
+func handleDelete(requestURL string) (*http.Request, error) {
+	return handleWithoutPayload(requestURL, http.MethodDelete)
+}
+
 func requestHandler(requestURL, requestMethod string, jsonPayload []byte) (*http.Request, error) {
 	switch requestMethod {
 	case http.MethodPut:
@@ -117,6 +93,8 @@ func requestHandler(requestURL, requestMethod string, jsonPayload []byte) (*http
 		return handlePutPost(requestURL, requestMethod, jsonPayload)
 	case http.MethodGet:
 		return handleGet(requestURL)
+	case http.MethodDelete:
+		return handleDelete(requestURL)
 	default:
 		return nil, fmt.Errorf("unsupported method '%s' provided", requestMethod)
 	}
@@ -134,13 +112,10 @@ func GetJSONPayload(payload interface{}) ([]byte, error) {
 	return bytes, nil
 }
 
-func GetRequest(requestPath string, requestMethod string, requestPayloadBytes []byte) (*http.Request, error) {
-	var req *http.Request
-	var err error
-
-	url := getRequestURL(requestPath)
-
-	if req, err = requestHandler(url, requestMethod, requestPayloadBytes); err != nil {
+// NewRequest returns request with the standard Veikkaus API headers for the fully resolved request URL
+func NewRequest(requestURL string, requestMethod string, requestPayloadBytes []byte) (*http.Request, error) {
+	req, err := requestHandler(requestURL, requestMethod, requestPayloadBytes)
+	if err != nil {
 		return nil, err
 	}
 
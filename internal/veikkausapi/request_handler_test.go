@@ -3,7 +3,6 @@ package veikkausapi
 import (
 	"context"
 	"errors"
-	"fmt"
 	"io"
 	"log"
 	"net/http"
@@ -14,11 +13,7 @@ import (
 )
 
 const (
-	ExpectedLoginURL    = "https://www.veikkaus.fi/api/bff/v1/sessions"
-	NoCookiesToday      = 0
-	SingleCookieInSlice = 1
-	DummyCookieCount    = 2
-	AppendedCookieCount = 3
+	ExpectedLoginURL = "https://www.veikkaus.fi/api/bff/v1/sessions"
 )
 
 type DummyPayload struct {
@@ -27,20 +22,6 @@ type DummyPayload struct {
 	Baz string
 }
 
-var dummyURL, _ = url.Parse("http://localhost:8080")
-
-var dummyCookie1 = &http.Cookie{
-	Name:  "cookie1",
-	Value: "value1",
-}
-
-var dummyCookie2 = &http.Cookie{
-	Name:  "cookie2",
-	Value: "value2",
-}
-
-var dummyCookies = []*http.Cookie{dummyCookie1, dummyCookie2}
-
 var dummyPayload = DummyPayload{
 	Foo: "foo",
 	Bar: "bar",
@@ -85,11 +66,6 @@ func getRequestBody(req *http.Request) []byte {
 }
 
 var _ = Describe("internal/veikkausapi: request-handler code", func() {
-	Describe("getRequestUrl", func() {
-		It(fmt.Sprintf("should return request url with '%s' as base-url and given path", BaseURL), func() {
-			Expect(getRequestURL("bff/v1/sessions")).To(Equal(ExpectedLoginURL))
-		})
-	})
 	Describe("setRequestHeaders", func() {
 		It("should set standard request headers for veikkaus api calls", func() {
 			dummyRequest, _ := http.NewRequest("GET", "foobar.com", nil)
@@ -197,6 +173,31 @@ var _ = Describe("internal/veikkausapi: request-handler code", func() {
 		Entry("should return error for malformatted url", "˛∞é®§", true, false, "Request URL was malformatted. ERR: parse \"˛∞é®§\": invalid URI for request"),
 		Entry("should return error when http.NewRequest unexpectedly returns error", "https://foobar.com/api/v2/baz", true, true, "Error creating 'GET'-request for https://foobar.com/api/v2/baz: mocked error"),
 	)
+	DescribeTable("handleDelete",
+		func(url string, expectError bool, expectedError string) {
+			if expectError {
+				originalNewRequest := newRequest
+				newRequest = mockNewRequestError
+
+				defer func() {
+					newRequest = originalNewRequest
+				}()
+
+				req, err := handleDelete(url)
+				Expect(err).NotTo(BeNil())
+				Expect(req).To(BeNil())
+				Expect(err.Error()).To(Equal(expectedError))
+			} else {
+				req, err := handleDelete(url)
+				Expect(err).To(BeNil())
+				Expect(req.Method).To(Equal("DELETE"))
+				Expect(req.Body).To(BeNil())
+				Expect(req.URL.String()).To(Equal(url))
+			}
+		},
+		Entry("should return DELETE-request when all fields are valid", "https://foobar.com/api/bff/v1/sessions", false, nil),
+		Entry("should return error when http.NewRequest unexpectedly returns error", "https://foobar.com/api/bff/v1/sessions", true, "Error creating 'DELETE'-request for https://foobar.com/api/bff/v1/sessions: mocked error"),
+	)
 	DescribeTable("requestHandler",
 		func(requestUrl, requestMethod string, jsonPayload []byte, expectError bool, expectedError string) {
 			if req, err := requestHandler(requestUrl, requestMethod, jsonPayload); expectError {
@@ -211,6 +212,7 @@ var _ = Describe("internal/veikkausapi: request-handler code", func() {
 		Entry("should return 'POST'-request with valid input", "https://localhost:8080", "POST", dummyPayloadBytes, false, nil),
 		Entry("should return 'PUT'-request with valid input", "https://localhost:8080", "PUT", dummyPayloadBytes, false, nil),
 		Entry("should return 'GET'-request with valid input", "https://localhost:8080", "GET", nil, false, nil),
+		Entry("should return 'DELETE'-request with valid input", "https://localhost:8080", "DELETE", nil, false, nil),
 		Entry("should return error when unsupported method is attempted", "https://localhost:8080", "DEL", nil, true, "unsupported method 'DEL' provided"),
 		Entry("should return error when request url is invalid for 'POST'", "www.*****", "POST", dummyPayloadBytes, true, "Request URL was malformatted. ERR: parse \"www.*****\": invalid URI for request"),
 		Entry("should return error when request url is invalid for 'PUT'", "**®é¸ƒ", "PUT", dummyPayloadBytes, true, "Request URL was malformatted. ERR: parse \"**®é¸ƒ\": invalid URI for request"),
@@ -231,9 +233,9 @@ var _ = Describe("internal/veikkausapi: request-handler code", func() {
 		Entry("should return payload as bytes-array when JSON-marshal is successful", dummyPayload, dummyPayloadBytes, false, nil),
 		Entry("should return error when payload cannot be processed to byte-array", unprocessableStruct, nil, true, "could not parse request-payload -interface to bytes"),
 	)
-	DescribeTable("GetRequest",
-		func(requestPath string, requestMethod string, requestPayload []byte, expectedRequestUrl string, expectError bool, expectedError string) {
-			if req, err := GetRequest(requestPath, requestMethod, requestPayload); expectError {
+	DescribeTable("NewRequest",
+		func(requestURL string, requestMethod string, requestPayload []byte, expectedRequestUrl string, expectError bool, expectedError string) {
+			if req, err := NewRequest(requestURL, requestMethod, requestPayload); expectError {
 				Expect(req).To(BeNil())
 				Expect(err).NotTo(BeNil())
 				Expect(err.Error()).To(Equal(expectedError))
@@ -241,90 +243,13 @@ var _ = Describe("internal/veikkausapi: request-handler code", func() {
 				Expect(err).To(BeNil())
 				Expect(req).NotTo(BeNil())
 				Expect(req.URL.String()).To(Equal(expectedRequestUrl))
+				Expect(req.Header.Get(RobotIdentifierHeaderKey)).To(Equal(RobotIdentifierHeaderValue))
 			}
 		},
-		Entry("should return 'GET'-request with valid parameters", "hello", "GET", nil, "https://www.veikkaus.fi/api/hello", false, nil),
-		Entry("should return 'POST'-request with valid parameters", "v2/bar", "POST", dummyPayloadBytes, "https://www.veikkaus.fi/api/v2/bar", false, nil),
-		Entry("should return 'PUT'-request with valid parameters", "foo/bar/baz", "PUT", dummyPayloadBytes, "https://www.veikkaus.fi/api/foo/bar/baz", false, nil),
-		Entry("should return error for invalid 'POST'-request", "irrelevant", "POST", invalidPayloadBytes, nil, true, "payload bytes were expected, received nil"),
-		Entry("should return error for invalid 'PUT'-request", "/", "PUT", invalidPayloadBytes, nil, true, "payload bytes were expected, received nil"),
-	)
-	DescribeTable("SetCookies",
-		func(requestCookies *RequestCookies, newCookies []*http.Cookie, originalCookieCount, expectedNumberOfCookies int) {
-			Expect(len(requestCookies.CookieSlice)).To(Equal(originalCookieCount))
-			requestCookies.SetCookies(dummyURL, newCookies)
-			Expect(len(requestCookies.CookieSlice)).To(Equal(expectedNumberOfCookies))
-		},
-		Entry("should add new cookies to empty cookie-slice", &RequestCookies{}, dummyCookies, NoCookiesToday, DummyCookieCount),
-		Entry(
-			"should add new cookies to non-empty cookie-slice",
-			&RequestCookies{
-				CookieSlice: []*http.Cookie{
-					{
-						Name:  "foo",
-						Value: "bar",
-					},
-				},
-			},
-			dummyCookies,
-			SingleCookieInSlice,
-			AppendedCookieCount,
-		),
+		Entry("should return 'GET'-request with valid parameters", ExpectedLoginURL, "GET", nil, ExpectedLoginURL, false, nil),
+		Entry("should return 'POST'-request with valid parameters", BaseURL+"v2/bar", "POST", dummyPayloadBytes, "https://www.veikkaus.fi/api/v2/bar", false, nil),
+		Entry("should return 'PUT'-request with valid parameters", BaseURL+"foo/bar/baz", "PUT", dummyPayloadBytes, "https://www.veikkaus.fi/api/foo/bar/baz", false, nil),
+		Entry("should return error for invalid 'POST'-request", BaseURL+"irrelevant", "POST", invalidPayloadBytes, nil, true, "payload bytes were expected, received nil"),
+		Entry("should return error for invalid 'PUT'-request", BaseURL, "PUT", invalidPayloadBytes, nil, true, "payload bytes were expected, received nil"),
 	)
-	DescribeTable("SetCookie",
-		func(requestCookies *RequestCookies, newCookie *http.Cookie, originalCookieCount, expectedNumberOfCookies int) {
-			Expect(len(requestCookies.CookieSlice)).To(Equal(originalCookieCount))
-			requestCookies.SetCookie(dummyURL, newCookie)
-			Expect(len(requestCookies.CookieSlice)).To(Equal(expectedNumberOfCookies))
-		},
-		Entry("should add new cookie to empty cookie-slice", &RequestCookies{}, dummyCookie2, NoCookiesToday, SingleCookieInSlice),
-		Entry(
-			"should add new cookie to non-empty cookie-slice",
-			&RequestCookies{
-				CookieSlice: []*http.Cookie{
-					{
-						Name:  "foo",
-						Value: "bar",
-					},
-				},
-			},
-			dummyCookie1,
-			SingleCookieInSlice,
-			DummyCookieCount,
-		),
-	)
-	DescribeTable("Cookies",
-		func(requestCookies *RequestCookies, expectedCookies []*http.Cookie) {
-			cookies := requestCookies.Cookies(dummyURL)
-			Expect(cookies).To(Equal(expectedCookies))
-		},
-		Entry("should return cookie-array from cookie-slice", &RequestCookies{CookieSlice: dummyCookies}, dummyCookies),
-	)
-	Describe("IsAuthenticated", func() {
-		var (
-			requestCookies *RequestCookies
-		)
-
-		BeforeEach(func() {
-			requestCookies = &RequestCookies{
-				CookieSlice: []*http.Cookie{
-					{
-						Name:  "foo",
-						Value: "bar",
-					},
-				},
-			}
-		})
-		DescribeTable("IsAuthenticated",
-			func(cookies []*http.Cookie, expected bool) {
-				requestCookies.SetCookies(dummyURL, cookies)
-				result := requestCookies.IsAuthenticated(dummyURL)
-				Expect(result).To(Equal(expected))
-			},
-			Entry("has no cookies", []*http.Cookie{}, false),
-			Entry("has authenticated cookie present", []*http.Cookie{{Name: AuthSessionCookie}}, true),
-			Entry("has authenticated cookie not present", []*http.Cookie{{Name: "other_cookie"}}, false),
-		)
-	})
-
 })
@@ -27,7 +27,7 @@ func HandleError(response *http.Response) error {
 		return fmt.Errorf("could not convert response to a byte-stream: %v", err)
 	}
 
-	return ParseAPIError(body)
+	return ParseAPIError(response, body)
 }
 
 func HandleResponse(response *http.Response, responseInterface interface{}) error {
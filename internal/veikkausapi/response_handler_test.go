@@ -1,7 +1,6 @@
 package veikkausapi
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -154,7 +153,7 @@ var _ = Describe("internal/veikkausapi: response-handler code", func() {
 			}
 			Expect(err).To(HaveOccurred())
 		},
-		Entry("should return error for failed byte-stream conversion", emptyResponseBody, &json.SyntaxError{}),
+		Entry("should return 'APIError' for empty error body", emptyResponseBody, &APIError{}),
 		Entry("should return unauthorized-error when unauthorized", &http.Response{
 			Status:     "401 Unauthorized",
 			StatusCode: 401,
@@ -169,12 +168,18 @@ var _ = Describe("internal/veikkausapi: response-handler code", func() {
 			Status:     "500 Internal Server Error",
 			StatusCode: 500,
 			Body:       io.NopCloser(strings.NewReader(`{"code":"UNKNOWN_ERROR"}`)),
-		}, &APIErrorNotImplementedError{}),
-		Entry("should return error for unprocessable bytes", &http.Response{
+		}, &APIError{}),
+		Entry("should return 'APIError' for unprocessable bytes", &http.Response{
 			Status:     "600",
 			StatusCode: 600,
 			Body:       io.NopCloser(strings.NewReader("")),
-		}, &json.SyntaxError{}),
+		}, &APIError{}),
+		Entry("should return 'APIError' for HTML error page", &http.Response{
+			Status:     "502 Bad Gateway",
+			StatusCode: http.StatusBadGateway,
+			Header:     http.Header{"Content-Type": []string{"text/html"}},
+			Body:       io.NopCloser(strings.NewReader("<html><body><h1>502 Bad Gateway</h1></body></html>")),
+		}, &APIError{}),
 		Entry("should return error for empty response body", &http.Response{
 			Body: nil,
 		}, nil),